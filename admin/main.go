@@ -0,0 +1,213 @@
+package main
+
+import (
+	"DobrikaDev/task-service/di"
+	"DobrikaDev/task-service/internal/service/searchindex"
+	"DobrikaDev/task-service/utils/config"
+	"DobrikaDev/task-service/utils/logger"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type command struct {
+	name        string
+	description string
+	run         func(ctx context.Context, container *di.Container, logger *zap.Logger, args []string) error
+}
+
+var commands = []command{
+	{
+		name:        "repair-counters",
+		description: "recompute participant counters on tasks from user_tasks",
+		run:         repairCounters,
+	},
+	{
+		name:        "reindex",
+		description: "queue tasks for the search indexer and optionally wait for the queue to drain",
+		run:         reindex,
+	},
+	{
+		name:        "index-status",
+		description: "show search indexer cursor, lag, queue depth and last run",
+		run:         indexStatus,
+	},
+	{
+		name:        "replay-index-jobs",
+		description: "queue dead-lettered index jobs again",
+		run:         replayIndexJobs,
+	},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	cfg := config.MustLoadConfigFromFile("deployments/config.yaml")
+	logger, _ := logger.NewLogger()
+	defer logger.Sync()
+	container := di.NewContainer(ctx, cfg, logger)
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+		if err := cmd.run(ctx, container, logger, os.Args[2:]); err != nil {
+			logger.Error("Command failed", zap.String("command", cmd.name), zap.Error(err))
+			os.Exit(1)
+		}
+		return
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: admin <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-20s %s\n", cmd.name, cmd.description)
+	}
+}
+
+func repairCounters(ctx context.Context, container *di.Container, logger *zap.Logger, args []string) error {
+	flags := flag.NewFlagSet("repair-counters", flag.ContinueOnError)
+	taskIDs := flags.String("task-ids", "", "comma-separated task ids to repair; all tasks when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	repaired, err := container.GetStorage().RecomputeParticipantCounters(ctx, splitList(*taskIDs))
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Participant counters repaired", zap.Int("tasks", repaired))
+	return nil
+}
+
+func reindex(ctx context.Context, container *di.Container, logger *zap.Logger, args []string) error {
+	flags := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	all := flags.Bool("all", false, "reindex every task")
+	customerID := flags.String("customer-id", "", "reindex the tasks of one customer")
+	taskIDs := flags.String("task-ids", "", "comma-separated task ids to reindex")
+	resetCursor := flags.Bool("reset-cursor", false, "make the next sweep walk every task again")
+	wait := flags.Bool("wait", false, "report progress until the index job queue is empty")
+	interval := flags.Duration("interval", 5*time.Second, "progress reporting interval with -wait")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	service := container.GetSearchIndexService()
+	result, err := service.Reindex(ctx, searchindex.ReindexOptions{
+		All:         *all,
+		CustomerID:  *customerID,
+		TaskIDs:     splitList(*taskIDs),
+		ResetCursor: *resetCursor,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Reindex queued", zap.Int("queued", result.Queued), zap.Int("pending", result.Pending))
+	if !*wait {
+		return nil
+	}
+
+	// The jobs are drained by the running service; this only watches.
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	pending := result.Pending
+	for pending > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		pending, err = service.PendingJobs(ctx)
+		if err != nil {
+			return err
+		}
+		logger.Info("Reindex progress", zap.Int("pending", pending))
+	}
+
+	logger.Info("Reindex finished")
+	return nil
+}
+
+func indexStatus(ctx context.Context, container *di.Container, logger *zap.Logger, args []string) error {
+	status, err := container.GetSearchIndexService().GetIndexerStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := []zap.Field{
+		zap.Time("cursor", status.Cursor),
+		zap.Duration("lag", status.Lag),
+		zap.Int("pending", status.Pending),
+		zap.Int("dead", status.Dead),
+	}
+	if status.LastRunAt != nil {
+		fields = append(fields, zap.Time("last_run_at", *status.LastRunAt))
+	}
+	if status.LastSuccessAt != nil {
+		fields = append(fields, zap.Time("last_success_at", *status.LastSuccessAt))
+	}
+	if status.LastErrorAt != nil {
+		fields = append(fields, zap.Time("last_error_at", *status.LastErrorAt), zap.String("last_error", status.LastError))
+	}
+	if status.BreakerState != "" {
+		fields = append(fields, zap.String("breaker_state", status.BreakerState))
+	}
+
+	logger.Info("Indexer status", fields...)
+	return nil
+}
+
+func replayIndexJobs(ctx context.Context, container *di.Container, logger *zap.Logger, args []string) error {
+	flags := flag.NewFlagSet("replay-index-jobs", flag.ContinueOnError)
+	rawIDs := flags.String("ids", "", "comma-separated dead job ids to replay; all dead jobs when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ids := make([]int64, 0)
+	for _, item := range splitList(*rawIDs) {
+		id, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid job id %q: %w", item, err)
+		}
+		ids = append(ids, id)
+	}
+
+	replayed, err := container.GetSearchIndexService().ReplayDeadIndexJobs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Dead index jobs replayed", zap.Int("replayed", replayed))
+	return nil
+}
+
+func splitList(value string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
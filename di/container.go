@@ -5,10 +5,22 @@ import (
 	"net"
 	"net/http"
 
+	"DobrikaDev/task-service/internal/broker"
 	"DobrikaDev/task-service/internal/delivery"
+	"DobrikaDev/task-service/internal/integration/notifier"
 	searchintegration "DobrikaDev/task-service/internal/integration/search"
+	webhookintegration "DobrikaDev/task-service/internal/integration/webhook"
 	"DobrikaDev/task-service/internal/jobs/indexer"
+	"DobrikaDev/task-service/internal/jobs/leader"
+	notificationjob "DobrikaDev/task-service/internal/jobs/notification"
+	"DobrikaDev/task-service/internal/jobs/outbox"
+	"DobrikaDev/task-service/internal/jobs/searchcache"
+	"DobrikaDev/task-service/internal/jobs/searchlog"
+	webhookjob "DobrikaDev/task-service/internal/jobs/webhook"
+	"DobrikaDev/task-service/internal/service/notification"
+	"DobrikaDev/task-service/internal/service/searchindex"
 	"DobrikaDev/task-service/internal/service/task"
+	"DobrikaDev/task-service/internal/service/webhook"
 	"DobrikaDev/task-service/internal/storage/sql"
 	"DobrikaDev/task-service/internal/storage/sqlxtrm"
 	"DobrikaDev/task-service/utils/config"
@@ -16,6 +28,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,8 +45,26 @@ type Container struct {
 	storage            *sql.SqlStorage
 	netListener        *net.Listener
 	grpcServer         *grpc.Server
-	searchClient       *searchintegration.Client
+	searchClient       searchintegration.Engine
+	searchConn         *grpc.ClientConn
+	taskSearcher       searchintegration.Searcher
+	searchCache        searchintegration.ResultCache
+	searchCacheWatcher *searchcache.Watcher
 	taskIndexer        *indexer.Scheduler
+	indexerElector     *leader.Elector
+	broker             *broker.MemoryBroker
+	eventPublisher     outbox.EventPublisher
+	outboxRelay        *outbox.Relay
+	webhookService     *webhook.WebhookService
+	webhookSender      *webhookintegration.Sender
+	webhookDispatcher  *webhookjob.Dispatcher
+
+	searchIndexService *searchindex.SearchIndexService
+	searchRecorder     *searchlog.Recorder
+
+	notificationService    *notification.NotificationService
+	notifier               notifier.Notifier
+	notificationDispatcher *notificationjob.Dispatcher
 }
 
 func NewContainer(ctx context.Context, cfg *config.Config, logger *zap.Logger) *Container {
@@ -42,7 +73,26 @@ func NewContainer(ctx context.Context, cfg *config.Config, logger *zap.Logger) *
 
 func (c *Container) GetTaskService() *task.TaskService {
 	return get(&c.taskService, func() *task.TaskService {
-		return task.NewTaskService(c.GetStorage(), c.cfg, c.logger, c.GetTaskIndexer(), c.GetSearchClient())
+		// A nil *indexer.Scheduler must reach the service as a nil interface,
+		// otherwise index jobs are queued with nobody to drain them.
+		var taskIndexer interface{ NotifyTaskChanged(taskID string) }
+		if scheduler := c.GetTaskIndexer(); scheduler != nil {
+			taskIndexer = scheduler
+		}
+
+		opts := []task.Option{task.WithBroker(c.GetBroker())}
+		if recorder := c.GetSearchRecorder(); recorder != nil {
+			opts = append(opts, task.WithSearchRecorder(recorder))
+		}
+
+		return task.NewTaskService(
+			c.GetStorage(),
+			c.cfg,
+			c.logger,
+			taskIndexer,
+			c.GetTaskSearcher(),
+			opts...,
+		)
 	})
 }
 
@@ -101,18 +151,122 @@ func (c *Container) GetGRPCServer() *grpc.Server {
 }
 func (c *Container) GetRpcServer() *delivery.Server {
 	return get(&c.server, func() *delivery.Server {
-		return delivery.NewServer(c.ctx, c.GetTaskService(), c.cfg, c.logger)
+		return delivery.NewServer(
+			c.ctx,
+			c.GetTaskService(),
+			c.cfg,
+			c.logger,
+			delivery.WithWebhookService(c.GetWebhookService()),
+			delivery.WithNotificationService(c.GetNotificationService()),
+			delivery.WithSearchIndexService(c.GetSearchIndexService()),
+		)
 	})
 }
 
-func (c *Container) GetSearchClient() *searchintegration.Client {
-	return get(&c.searchClient, func() *searchintegration.Client {
-		client, err := searchintegration.New(c.cfg.Search, c.GetHTTPClient(), searchintegration.WithLogger(c.logger))
+// GetSearchClient returns the search server transport chosen by
+// Search.Transport wrapped with retries and a circuit breaker, or nil when it
+// is not configured.
+func (c *Container) GetSearchClient() searchintegration.Engine {
+	return get(&c.searchClient, func() searchintegration.Engine {
+		var (
+			client searchintegration.Engine
+			err    error
+		)
+		if c.cfg.Search.Transport == searchintegration.TransportGRPC {
+			// A nil *grpc.ClientConn would reach NewGRPC as a non-nil
+			// interface and panic on the first call.
+			conn := c.GetSearchConn()
+			if conn == nil {
+				c.logger.Error("failed to create search client: grpc address is not configured")
+				return nil
+			}
+			client, err = searchintegration.NewGRPC(c.cfg.Search, conn, searchintegration.WithGRPCLogger(c.logger))
+		} else {
+			client, err = searchintegration.New(c.cfg.Search, c.GetHTTPClient(), searchintegration.WithLogger(c.logger))
+		}
 		if err != nil {
 			c.logger.Error("failed to create search client", zap.Error(err))
 			return nil
 		}
-		return client
+
+		return searchintegration.NewResilient(client, c.cfg.Search, c.logger)
+	})
+}
+
+func (c *Container) GetSearchConn() *grpc.ClientConn {
+	return get(&c.searchConn, func() *grpc.ClientConn {
+		if c.cfg.Search.GRPCAddress == "" {
+			return nil
+		}
+
+		conn, err := grpc.NewClient(c.cfg.Search.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			c.logger.Error("failed to create search connection", zap.Error(err))
+			return nil
+		}
+		return conn
+	})
+}
+
+// GetTaskSearcher combines the search service and Postgres full-text search
+// according to Search.FullTextMode, behind the result cache when it is
+// enabled. It returns nil when neither backend is available.
+func (c *Container) GetTaskSearcher() searchintegration.Searcher {
+	return get(&c.taskSearcher, func() searchintegration.Searcher {
+		searcher := c.newTaskSearcher()
+		if searcher == nil {
+			return nil
+		}
+		if cache := c.GetSearchCache(); cache != nil {
+			c.GetSearchCacheWatcher()
+			return searchintegration.NewCached(searcher, cache)
+		}
+		return searcher
+	})
+}
+
+func (c *Container) newTaskSearcher() searchintegration.Searcher {
+	fullText := searchintegration.NewFullText(c.GetStorage(), c.cfg.Search)
+
+	switch c.cfg.Search.FullTextMode {
+	case searchintegration.FullTextModePrimary:
+		return fullText
+	case searchintegration.FullTextModeDisabled:
+		if client := c.GetSearchClient(); client != nil {
+			return client
+		}
+		return nil
+	default:
+		client := c.GetSearchClient()
+		if client == nil {
+			return fullText
+		}
+		return searchintegration.NewFallback(client, fullText, c.logger)
+	}
+}
+
+// GetSearchCache returns the search result cache, or nil when Search.CacheTTL
+// is zero.
+func (c *Container) GetSearchCache() searchintegration.ResultCache {
+	return get(&c.searchCache, func() searchintegration.ResultCache {
+		if c.cfg.Search.CacheTTL <= 0 {
+			return nil
+		}
+		return searchintegration.NewMemoryCache(c.cfg.Search.CacheTTL, c.cfg.Search.CacheMaxEntries)
+	})
+}
+
+// GetSearchCacheWatcher applies the indexer's invalidations to the search
+// cache of this replica. It returns nil when the cache is disabled.
+func (c *Container) GetSearchCacheWatcher() *searchcache.Watcher {
+	return get(&c.searchCacheWatcher, func() *searchcache.Watcher {
+		cache := c.GetSearchCache()
+		if cache == nil {
+			return nil
+		}
+		watcher := searchcache.NewWatcher(c.GetStorage(), cache, c.cfg.Search, c.logger)
+		watcher.Start(c.ctx)
+		return watcher
 	})
 }
 
@@ -122,12 +276,178 @@ func (c *Container) GetTaskIndexer() *indexer.Scheduler {
 		if client == nil {
 			return nil
 		}
-		scheduler := indexer.NewScheduler(c.GetStorage(), client, c.cfg.Search, c.logger)
+		opts := []indexer.Option{indexer.WithLeadership(c.GetIndexerElector())}
+		// Only the leader indexes, but every replica caches: invalidations go
+		// through the database to the watcher of each replica.
+		if c.GetSearchCache() != nil {
+			opts = append(opts, indexer.WithCacheInvalidator(searchcache.NewBroadcaster(c.GetStorage(), c.logger)))
+		}
+		scheduler := indexer.NewScheduler(c.GetStorage(), client, c.cfg.Search, c.logger, opts...)
 		scheduler.Start(c.ctx)
 		return scheduler
 	})
 }
 
+// GetIndexerElector elects the one replica that runs the search indexer.
+func (c *Container) GetIndexerElector() *leader.Elector {
+	return get(&c.indexerElector, func() *leader.Elector {
+		elector := leader.NewElector(c.GetDB(), "task-service.search-indexer", c.cfg.Leader, c.logger)
+		elector.Start(c.ctx)
+		return elector
+	})
+}
+
+// GetSearchIndexService only queues work, so it does not start the indexer;
+// the scheduler of the serving process drains the jobs.
+func (c *Container) GetSearchIndexService() *searchindex.SearchIndexService {
+	return get(&c.searchIndexService, func() *searchindex.SearchIndexService {
+		client := c.GetSearchClient()
+		var opts []searchindex.Option
+		if resilient, ok := client.(*searchintegration.Resilient); ok {
+			opts = append(opts, searchindex.WithBreaker(resilient))
+		}
+		return searchindex.NewSearchIndexService(c.GetStorage(), client != nil, c.logger, opts...)
+	})
+}
+
+// GetSearchRecorder returns the search analytics recorder, or nil when
+// Analytics.Enabled is off.
+func (c *Container) GetSearchRecorder() *searchlog.Recorder {
+	return get(&c.searchRecorder, func() *searchlog.Recorder {
+		if !c.cfg.Analytics.Enabled {
+			return nil
+		}
+		recorder := searchlog.NewRecorder(c.GetStorage(), c.cfg.Analytics, c.logger)
+		// Shutdown stops the recorder after the last RPC, so it must outlive
+		// the cancellation of c.ctx.
+		recorder.Start(context.WithoutCancel(c.ctx))
+		return recorder
+	})
+}
+
+func (c *Container) GetBroker() *broker.MemoryBroker {
+	return get(&c.broker, func() *broker.MemoryBroker {
+		return broker.NewMemoryBroker(c.logger)
+	})
+}
+
+func (c *Container) GetEventPublisher() outbox.EventPublisher {
+	return get(&c.eventPublisher, func() outbox.EventPublisher {
+		var publisher outbox.EventPublisher
+		switch c.cfg.Outbox.Publisher {
+		case "file":
+			filePublisher, err := outbox.NewFilePublisher(c.cfg.Outbox.FilePath)
+			if err != nil {
+				panic(err)
+			}
+			publisher = filePublisher
+		case "memory":
+			publisher = outbox.NewMemoryPublisher()
+		default:
+			publisher = outbox.NewLogPublisher(c.logger)
+		}
+
+		return outbox.NewMultiPublisher(
+			publisher,
+			webhookjob.NewPublisher(c.GetStorage()),
+			notificationjob.NewPublisher(c.GetStorage()),
+		)
+	})
+}
+
+func (c *Container) GetOutboxRelay() *outbox.Relay {
+	return get(&c.outboxRelay, func() *outbox.Relay {
+		relay := outbox.NewRelay(c.GetStorage(), c.GetEventPublisher(), c.cfg.Outbox, c.logger)
+		relay.Start(c.ctx)
+		return relay
+	})
+}
+
+func (c *Container) GetWebhookService() *webhook.WebhookService {
+	return get(&c.webhookService, func() *webhook.WebhookService {
+		return webhook.NewWebhookService(c.GetStorage(), c.logger)
+	})
+}
+
+func (c *Container) GetWebhookSender() *webhookintegration.Sender {
+	return get(&c.webhookSender, func() *webhookintegration.Sender {
+		// Webhook targets are customer-supplied, so they get a client that
+		// cannot dial into the internal network.
+		client := webhookintegration.NewHTTPClient(c.cfg.Webhook.RequestTimeout)
+		return webhookintegration.NewSender(client, c.cfg.Webhook.RequestTimeout)
+	})
+}
+
+func (c *Container) GetWebhookDispatcher() *webhookjob.Dispatcher {
+	return get(&c.webhookDispatcher, func() *webhookjob.Dispatcher {
+		dispatcher := webhookjob.NewDispatcher(c.GetStorage(), c.GetWebhookSender(), c.cfg.Webhook, c.logger)
+		dispatcher.Start(c.ctx)
+		return dispatcher
+	})
+}
+
+func (c *Container) GetNotificationService() *notification.NotificationService {
+	return get(&c.notificationService, func() *notification.NotificationService {
+		return notification.NewNotificationService(c.GetStorage(), c.logger)
+	})
+}
+
+func (c *Container) GetNotifier() notifier.Notifier {
+	return get(&c.notifier, func() notifier.Notifier {
+		switch c.cfg.Notification.Notifier {
+		case "memory":
+			return notifier.NewMemoryNotifier()
+		default:
+			return notifier.NewConsoleNotifier(c.logger)
+		}
+	})
+}
+
+func (c *Container) GetNotificationDispatcher() *notificationjob.Dispatcher {
+	return get(&c.notificationDispatcher, func() *notificationjob.Dispatcher {
+		dispatcher := notificationjob.NewDispatcher(c.GetStorage(), c.GetNotifier(), c.cfg.Notification, c.logger)
+		dispatcher.Start(c.ctx)
+		return dispatcher
+	})
+}
+
+// Shutdown closes watch streams, stops background jobs and then waits for
+// in-flight RPCs to finish.
+func (c *Container) Shutdown() {
+	if c.broker != nil {
+		c.broker.Close()
+	}
+	if c.taskIndexer != nil {
+		c.taskIndexer.Stop()
+	}
+	// Hand the indexer role over only after the local scheduler has stopped.
+	if c.indexerElector != nil {
+		c.indexerElector.Stop()
+	}
+	if c.outboxRelay != nil {
+		c.outboxRelay.Stop()
+	}
+	if c.webhookDispatcher != nil {
+		c.webhookDispatcher.Stop()
+	}
+	if c.notificationDispatcher != nil {
+		c.notificationDispatcher.Stop()
+	}
+	if c.searchCacheWatcher != nil {
+		c.searchCacheWatcher.Stop()
+	}
+	if c.grpcServer != nil {
+		c.grpcServer.GracefulStop()
+	}
+	// Flush the searches of the RPCs that just finished.
+	if c.searchRecorder != nil {
+		c.searchRecorder.Stop()
+	}
+	if c.searchConn != nil {
+		_ = c.searchConn.Close()
+	}
+}
+
 func get[T comparable](obj *T, builder func() T) T {
 	if *obj != *new(T) {
 		return *obj
@@ -0,0 +1,65 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+)
+
+var (
+	ErrClosed       = errors.New("broker: closed")
+	ErrEventExpired = errors.New("broker: event id is no longer available")
+	ErrInvalidID    = errors.New("broker: invalid event id")
+)
+
+type EventType string
+
+const (
+	EventTaskCreated     EventType = "task_created"
+	EventTaskUpdated     EventType = "task_updated"
+	EventTaskDeleted     EventType = "task_deleted"
+	EventUserTaskCreated EventType = "user_task_created"
+	EventUserTaskUpdated EventType = "user_task_updated"
+)
+
+// Event describes one mutation made by TaskService. ID is assigned by the
+// broker on publish and is opaque to subscribers.
+type Event struct {
+	ID         string
+	Type       EventType
+	TaskID     string
+	UserID     string
+	Task       *domain.Task
+	UserTask   *domain.UserTask
+	OccurredAt time.Time
+}
+
+// Filter selects the events a subscriber receives. Empty fields match any value.
+type Filter struct {
+	TaskID string
+	UserID string
+}
+
+func (f Filter) Match(event Event) bool {
+	if f.TaskID != "" && f.TaskID != event.TaskID {
+		return false
+	}
+	if f.UserID != "" && f.UserID != event.UserID {
+		return false
+	}
+	return true
+}
+
+// Broker fans task events out to watchers. Implementations must be safe for
+// concurrent use; the in-process MemoryBroker can be replaced with a
+// distributed one without touching TaskService or the gRPC handlers.
+type Broker interface {
+	Publish(ctx context.Context, event Event) error
+	// Subscribe streams matching events until ctx is done or the broker is
+	// closed, at which point the channel is closed. A non-empty afterID first
+	// replays retained events published after it.
+	Subscribe(ctx context.Context, filter Filter, afterID string) (<-chan Event, error)
+	Close()
+}
@@ -0,0 +1,210 @@
+package broker
+
+import (
+	"context"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRetention  = 1024
+	defaultSubscriber = 64
+)
+
+type subscriber struct {
+	filter Filter
+	events chan Event
+}
+
+// MemoryBroker keeps the last retention events in a ring for resumption and
+// delivers to subscribers through buffered channels. A subscriber that falls
+// behind by more than its buffer is disconnected and has to resume from the
+// last event it saw.
+//
+// Event ids are "<epoch>-<seq>", where epoch is random per broker. The
+// sequence restarts with every process and differs between replicas, so an id
+// from another epoch is reported as expired rather than replayed against an
+// unrelated history.
+type MemoryBroker struct {
+	mu          sync.Mutex
+	epoch       string
+	lastID      uint64
+	history     []Event
+	retention   int
+	bufferSize  int
+	subscribers map[*subscriber]struct{}
+	closed      bool
+	logger      *zap.Logger
+}
+
+type MemoryOption func(*MemoryBroker)
+
+func WithRetention(retention int) MemoryOption {
+	return func(b *MemoryBroker) {
+		if retention > 0 {
+			b.retention = retention
+		}
+	}
+}
+
+func WithSubscriberBuffer(size int) MemoryOption {
+	return func(b *MemoryBroker) {
+		if size > 0 {
+			b.bufferSize = size
+		}
+	}
+}
+
+func NewMemoryBroker(logger *zap.Logger, opts ...MemoryOption) *MemoryBroker {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	b := &MemoryBroker{
+		epoch:       strconv.FormatUint(rand.Uint64(), 36),
+		retention:   defaultRetention,
+		bufferSize:  defaultSubscriber,
+		subscribers: make(map[*subscriber]struct{}),
+		logger:      logger,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+func (b *MemoryBroker) Publish(_ context.Context, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrClosed
+	}
+
+	b.lastID++
+	event.ID = b.epoch + "-" + strconv.FormatUint(b.lastID, 10)
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.retention {
+		b.history = b.history[len(b.history)-b.retention:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.filter.Match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			b.logger.Warn("watch subscriber is too slow, disconnecting", zap.String("event_id", event.ID))
+			b.dropLocked(sub)
+		}
+	}
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, filter Filter, afterID string) (<-chan Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	replay, err := b.replayLocked(filter, afterID)
+	if err != nil {
+		return nil, err
+	}
+
+	size := b.bufferSize
+	if len(replay) > size {
+		size = len(replay) + b.bufferSize
+	}
+
+	sub := &subscriber{filter: filter, events: make(chan Event, size)}
+	for _, event := range replay {
+		sub.events <- event
+	}
+	b.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		b.dropLocked(sub)
+		b.mu.Unlock()
+	}()
+
+	return sub.events, nil
+}
+
+func (b *MemoryBroker) replayLocked(filter Filter, afterID string) ([]Event, error) {
+	if afterID == "" {
+		return nil, nil
+	}
+
+	epoch, seq, ok := strings.Cut(afterID, "-")
+	if !ok || epoch == "" {
+		return nil, ErrInvalidID
+	}
+	after, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if epoch != b.epoch {
+		return nil, ErrEventExpired
+	}
+	if after > b.lastID {
+		return nil, ErrInvalidID
+	}
+	if after == b.lastID {
+		return nil, nil
+	}
+
+	oldest := b.lastID - uint64(len(b.history)) + 1
+	if len(b.history) == 0 || after+1 < oldest {
+		return nil, ErrEventExpired
+	}
+
+	replay := make([]Event, 0)
+	for _, event := range b.history[after+1-oldest:] {
+		if filter.Match(event) {
+			replay = append(replay, event)
+		}
+	}
+
+	return replay, nil
+}
+
+func (b *MemoryBroker) dropLocked(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+// Close disconnects every subscriber and rejects further publishes.
+func (b *MemoryBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	b.closed = true
+
+	for sub := range b.subscribers {
+		b.dropLocked(sub)
+	}
+}
@@ -0,0 +1,60 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func publishN(t *testing.T, b *MemoryBroker, n int) []string {
+	t.Helper()
+
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		if err := b.Publish(context.Background(), Event{Type: EventTaskUpdated, TaskID: "task-1"}); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+		ids = append(ids, b.history[len(b.history)-1].ID)
+	}
+	return ids
+}
+
+func TestMemoryBrokerResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewMemoryBroker(nil)
+	ids := publishN(t, b, 3)
+
+	events, err := b.Subscribe(ctx, Filter{}, ids[0])
+	if err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+	for _, want := range ids[1:] {
+		if got := (<-events).ID; got != want {
+			t.Fatalf("replayed %s, want %s", got, want)
+		}
+	}
+}
+
+func TestMemoryBrokerRejectsIDsFromAnotherEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	// A client resumes on a restarted or different replica that has already
+	// published past the client's sequence number.
+	previous := NewMemoryBroker(nil)
+	stale := publishN(t, previous, 2)[1]
+
+	current := NewMemoryBroker(nil)
+	publishN(t, current, 5)
+
+	if _, err := current.Subscribe(ctx, Filter{}, stale); !errors.Is(err, ErrEventExpired) {
+		t.Fatalf("subscribe after %s = %v, want ErrEventExpired", stale, err)
+	}
+
+	for _, id := range []string{"5", "-5", "x-y"} {
+		if _, err := current.Subscribe(ctx, Filter{}, id); !errors.Is(err, ErrInvalidID) {
+			t.Fatalf("subscribe after %q = %v, want ErrInvalidID", id, err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+
+	"DobrikaDev/task-service/internal/domain"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+	"DobrikaDev/task-service/internal/service/task"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+const maxBatchSize = 100
+
+func (s *Server) BatchGetTasks(ctx context.Context, req *taskpb.BatchGetTasksRequest) (*taskpb.BatchGetTasksResponse, error) {
+	ids := req.GetIds()
+	if len(ids) == 0 {
+		return &taskpb.BatchGetTasksResponse{
+			Error: validationError("ids are required"),
+		}, nil
+	}
+	if len(ids) > maxBatchSize {
+		return &taskpb.BatchGetTasksResponse{
+			Error: validationError(fmt.Sprintf("at most %d ids are allowed", maxBatchSize)),
+		}, nil
+	}
+
+	tasks, err := s.taskService.BatchGetTasks(ctx, ids)
+	if err != nil {
+		return &taskpb.BatchGetTasksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	found := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		found[task.ID] = struct{}{}
+	}
+
+	missing := make([]string, 0)
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	s.logger.Info("tasks batch fetched", zap.Int("requested", len(ids)), zap.Int("found", len(tasks)))
+
+	return &taskpb.BatchGetTasksResponse{
+		Tasks:      gospadi.Map(tasks, convertTaskToProto),
+		MissingIds: missing,
+	}, nil
+}
+
+func (s *Server) BatchCreateTasks(ctx context.Context, req *taskpb.BatchCreateTasksRequest) (*taskpb.BatchCreateTasksResponse, error) {
+	results, batchErr := s.runBatch(ctx, req.GetTasks(), req.GetMode(), convertCreateTaskToDomain, s.taskService.BatchCreateTasks)
+
+	s.logger.Info("tasks batch created", zap.Int("count", len(req.GetTasks())), zap.Any("mode", req.GetMode()))
+
+	return &taskpb.BatchCreateTasksResponse{
+		Results: results,
+		Error:   batchErr,
+	}, nil
+}
+
+func (s *Server) BatchUpdateTasks(ctx context.Context, req *taskpb.BatchUpdateTasksRequest) (*taskpb.BatchUpdateTasksResponse, error) {
+	results, batchErr := s.runBatch(ctx, req.GetTasks(), req.GetMode(), convertUpdateTaskToDomain, s.taskService.BatchUpdateTasks)
+
+	s.logger.Info("tasks batch updated", zap.Int("count", len(req.GetTasks())), zap.Any("mode", req.GetMode()))
+
+	return &taskpb.BatchUpdateTasksResponse{
+		Results: results,
+		Error:   batchErr,
+	}, nil
+}
+
+// runBatch validates every payload, hands the valid ones to the service and
+// maps the per-item results back onto the request indexes. In atomic mode a
+// single invalid payload rejects the whole batch before touching storage.
+func (s *Server) runBatch(
+	ctx context.Context,
+	payloads []*taskpb.Task,
+	mode taskpb.BatchMode,
+	convert func(*taskpb.Task) (*domain.Task, *taskpb.Error),
+	apply func(context.Context, []*domain.Task, task.BatchMode) ([]task.BatchResult, error),
+) ([]*taskpb.BatchTaskResult, *taskpb.Error) {
+	if len(payloads) == 0 {
+		return nil, validationError("tasks are required")
+	}
+	if len(payloads) > maxBatchSize {
+		return nil, validationError(fmt.Sprintf("at most %d tasks are allowed", maxBatchSize))
+	}
+
+	batchMode := convertBatchModeToDomain(mode)
+
+	results := make([]*taskpb.BatchTaskResult, len(payloads))
+	tasks := make([]*domain.Task, 0, len(payloads))
+	indexes := make([]int, 0, len(payloads))
+
+	for i, payload := range payloads {
+		results[i] = &taskpb.BatchTaskResult{Index: int32(i)}
+
+		var item *domain.Task
+		var validationErr *taskpb.Error
+		if payload == nil {
+			validationErr = validationError("task is required")
+		} else {
+			item, validationErr = convert(payload)
+		}
+
+		if validationErr != nil {
+			results[i].Error = validationErr
+			if batchMode == task.BatchModeAtomic {
+				return results, validationError(fmt.Sprintf("task at index %d is invalid: %s", i, validationErr.Message))
+			}
+			continue
+		}
+
+		tasks = append(tasks, item)
+		indexes = append(indexes, i)
+	}
+
+	if len(tasks) == 0 {
+		return results, nil
+	}
+
+	applied, err := apply(ctx, tasks, batchMode)
+	if applied == nil && err != nil {
+		return nil, convertErrorToProto(err)
+	}
+
+	for i, result := range applied {
+		target := results[indexes[i]]
+		if result.Err != nil {
+			target.Error = convertErrorToProto(result.Err)
+			continue
+		}
+		if result.Task != nil {
+			target.Task = convertTaskToProto(result.Task)
+		}
+	}
+
+	return results, convertErrorToProto(err)
+}
+
+func convertBatchModeToDomain(mode taskpb.BatchMode) task.BatchMode {
+	switch mode {
+	case taskpb.BatchMode_BATCH_MODE_PARTIAL:
+		return task.BatchModePartial
+	default:
+		return task.BatchModeAtomic
+	}
+}
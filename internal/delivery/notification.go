@@ -0,0 +1,214 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	"DobrikaDev/task-service/internal/domain"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+var errNotificationsDisabled = errors.New("notifications are not configured")
+
+func (s *Server) ListNotifications(ctx context.Context, req *taskpb.ListNotificationsRequest) (*taskpb.ListNotificationsResponse, error) {
+	if s.notificationService == nil {
+		return &taskpb.ListNotificationsResponse{Error: internalError(errNotificationsDisabled)}, nil
+	}
+	if req.GetRecipientId() == "" {
+		return &taskpb.ListNotificationsResponse{
+			Error: validationError("recipient id is required"),
+		}, nil
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &taskpb.ListNotificationsResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+
+	page, err := s.notificationService.ListNotifications(
+		ctx,
+		req.GetRecipientId(),
+		req.GetUnreadOnly(),
+		int(req.GetLimit()),
+		int(req.GetOffset()),
+	)
+	if err != nil {
+		return &taskpb.ListNotificationsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.ListNotificationsResponse{
+		Notifications: gospadi.Map(page.Notifications, convertNotificationToProto),
+		Total:         int32(page.Total),
+		Unread:        int32(page.Unread),
+	}, nil
+}
+
+func (s *Server) MarkNotificationsRead(ctx context.Context, req *taskpb.MarkNotificationsReadRequest) (*taskpb.MarkNotificationsReadResponse, error) {
+	if s.notificationService == nil {
+		return &taskpb.MarkNotificationsReadResponse{Error: internalError(errNotificationsDisabled)}, nil
+	}
+	if req.GetRecipientId() == "" {
+		return &taskpb.MarkNotificationsReadResponse{
+			Error: validationError("recipient id is required"),
+		}, nil
+	}
+
+	updated, err := s.notificationService.MarkRead(ctx, req.GetRecipientId(), req.GetIds())
+	if err != nil {
+		return &taskpb.MarkNotificationsReadResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("notifications marked read", zap.String("recipient_id", req.GetRecipientId()), zap.Int("updated", updated))
+
+	return &taskpb.MarkNotificationsReadResponse{
+		Updated: int32(updated),
+	}, nil
+}
+
+func (s *Server) GetNotificationPreferences(
+	ctx context.Context,
+	req *taskpb.GetNotificationPreferencesRequest,
+) (*taskpb.GetNotificationPreferencesResponse, error) {
+	if s.notificationService == nil {
+		return &taskpb.GetNotificationPreferencesResponse{Error: internalError(errNotificationsDisabled)}, nil
+	}
+	if req.GetUserId() == "" {
+		return &taskpb.GetNotificationPreferencesResponse{
+			Error: validationError("user id is required"),
+		}, nil
+	}
+
+	preferences, err := s.notificationService.GetPreferences(ctx, req.GetUserId())
+	if err != nil {
+		return &taskpb.GetNotificationPreferencesResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.GetNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToProto(preferences),
+	}, nil
+}
+
+func (s *Server) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *taskpb.UpdateNotificationPreferencesRequest,
+) (*taskpb.UpdateNotificationPreferencesResponse, error) {
+	if s.notificationService == nil {
+		return &taskpb.UpdateNotificationPreferencesResponse{Error: internalError(errNotificationsDisabled)}, nil
+	}
+	if req.GetPreferences().GetUserId() == "" {
+		return &taskpb.UpdateNotificationPreferencesResponse{
+			Error: validationError("user id is required"),
+		}, nil
+	}
+
+	preferences, ok := convertNotificationPreferencesToDomain(req.GetPreferences())
+	if !ok {
+		return &taskpb.UpdateNotificationPreferencesResponse{
+			Error: validationError("unknown notification channel"),
+		}, nil
+	}
+
+	saved, err := s.notificationService.UpdatePreferences(ctx, preferences)
+	if err != nil {
+		return &taskpb.UpdateNotificationPreferencesResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("notification preferences updated", zap.String("user_id", saved.UserID))
+
+	return &taskpb.UpdateNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferencesToProto(saved),
+	}, nil
+}
+
+func convertNotificationToProto(notification *domain.Notification) *taskpb.Notification {
+	return &taskpb.Notification{
+		Id:          notification.ID,
+		RecipientId: notification.RecipientID,
+		Type:        convertNotificationTypeToProto(notification.Type),
+		TaskId:      notification.TaskID,
+		Title:       notification.Title,
+		Body:        notification.Body,
+		Read:        notification.ReadAt != nil,
+		CreatedAt:   int32(notification.CreatedAt.Unix()),
+	}
+}
+
+func convertNotificationTypeToProto(notificationType domain.NotificationType) taskpb.NotificationType {
+	switch notificationType {
+	case domain.NotificationTaskApproved:
+		return taskpb.NotificationType_NOTIFICATION_TYPE_TASK_APPROVED
+	case domain.NotificationTaskRejected:
+		return taskpb.NotificationType_NOTIFICATION_TYPE_TASK_REJECTED
+	case domain.NotificationParticipantJoined:
+		return taskpb.NotificationType_NOTIFICATION_TYPE_PARTICIPANT_JOINED
+	case domain.NotificationParticipantConfirmed:
+		return taskpb.NotificationType_NOTIFICATION_TYPE_PARTICIPANT_CONFIRMED
+	default:
+		return taskpb.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
+
+func convertNotificationPreferencesToProto(preferences *domain.NotificationPreferences) *taskpb.NotificationPreferences {
+	result := &taskpb.NotificationPreferences{
+		UserId:   preferences.UserID,
+		Channels: gospadi.Map(preferences.ChannelList(), convertNotificationChannelToProto),
+		Timezone: preferences.Timezone,
+	}
+	if preferences.QuietHoursStart != nil && preferences.QuietHoursEnd != nil {
+		start, end := int32(*preferences.QuietHoursStart), int32(*preferences.QuietHoursEnd)
+		result.QuietHoursStart = &start
+		result.QuietHoursEnd = &end
+	}
+	return result
+}
+
+func convertNotificationPreferencesToDomain(preferences *taskpb.NotificationPreferences) (*domain.NotificationPreferences, bool) {
+	channels := make([]domain.NotificationChannel, 0, len(preferences.GetChannels()))
+	for _, channel := range preferences.GetChannels() {
+		switch channel {
+		case taskpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH:
+			channels = append(channels, domain.NotificationChannelPush)
+		case taskpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+			channels = append(channels, domain.NotificationChannelEmail)
+		default:
+			return nil, false
+		}
+	}
+
+	result := &domain.NotificationPreferences{
+		UserID:   preferences.GetUserId(),
+		Timezone: preferences.GetTimezone(),
+	}
+	result.SetChannels(channels)
+	if preferences.QuietHoursStart != nil {
+		start := int(preferences.GetQuietHoursStart())
+		result.QuietHoursStart = &start
+	}
+	if preferences.QuietHoursEnd != nil {
+		end := int(preferences.GetQuietHoursEnd())
+		result.QuietHoursEnd = &end
+	}
+	return result, true
+}
+
+func convertNotificationChannelToProto(channel domain.NotificationChannel) taskpb.NotificationChannel {
+	switch channel {
+	case domain.NotificationChannelPush:
+		return taskpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	case domain.NotificationChannelEmail:
+		return taskpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	default:
+		return taskpb.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
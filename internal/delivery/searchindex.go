@@ -0,0 +1,141 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+	"DobrikaDev/task-service/internal/service/searchindex"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+var errSearchIndexDisabled = errors.New("search index administration is not configured")
+
+func (s *Server) ReindexTasks(ctx context.Context, req *taskpb.ReindexTasksRequest) (*taskpb.ReindexTasksResponse, error) {
+	if s.searchIndexService == nil {
+		return &taskpb.ReindexTasksResponse{Error: internalError(errSearchIndexDisabled)}, nil
+	}
+	if !req.GetAll() && req.GetCustomerId() == "" && len(req.GetTaskIds()) == 0 && !req.GetResetCursor() {
+		return &taskpb.ReindexTasksResponse{
+			Error: validationError("one of all, customer id, task ids or reset cursor is required"),
+		}, nil
+	}
+
+	result, err := s.searchIndexService.Reindex(ctx, searchindex.ReindexOptions{
+		All:         req.GetAll(),
+		CustomerID:  req.GetCustomerId(),
+		TaskIDs:     req.GetTaskIds(),
+		ResetCursor: req.GetResetCursor(),
+	})
+	if err != nil {
+		return &taskpb.ReindexTasksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("reindex requested", zap.Int("queued", result.Queued), zap.Int("pending", result.Pending))
+
+	return &taskpb.ReindexTasksResponse{
+		Queued:  int32(result.Queued),
+		Pending: int32(result.Pending),
+	}, nil
+}
+
+func (s *Server) GetIndexerStatus(ctx context.Context, req *taskpb.GetIndexerStatusRequest) (*taskpb.GetIndexerStatusResponse, error) {
+	if s.searchIndexService == nil {
+		return &taskpb.GetIndexerStatusResponse{Error: internalError(errSearchIndexDisabled)}, nil
+	}
+
+	status, err := s.searchIndexService.GetIndexerStatus(ctx)
+	if err != nil {
+		return &taskpb.GetIndexerStatusResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.GetIndexerStatusResponse{
+		Status: convertIndexerStatusToProto(status),
+	}, nil
+}
+
+func (s *Server) ListDeadIndexJobs(ctx context.Context, req *taskpb.ListDeadIndexJobsRequest) (*taskpb.ListDeadIndexJobsResponse, error) {
+	if s.searchIndexService == nil {
+		return &taskpb.ListDeadIndexJobsResponse{Error: internalError(errSearchIndexDisabled)}, nil
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &taskpb.ListDeadIndexJobsResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+
+	jobs, total, err := s.searchIndexService.ListDeadIndexJobs(ctx, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return &taskpb.ListDeadIndexJobsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.ListDeadIndexJobsResponse{
+		Jobs:  gospadi.Map(jobs, convertIndexJobToProto),
+		Total: int32(total),
+	}, nil
+}
+
+func (s *Server) ReplayIndexJobs(ctx context.Context, req *taskpb.ReplayIndexJobsRequest) (*taskpb.ReplayIndexJobsResponse, error) {
+	if s.searchIndexService == nil {
+		return &taskpb.ReplayIndexJobsResponse{Error: internalError(errSearchIndexDisabled)}, nil
+	}
+
+	replayed, err := s.searchIndexService.ReplayDeadIndexJobs(ctx, req.GetIds())
+	if err != nil {
+		return &taskpb.ReplayIndexJobsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("index jobs replayed", zap.Int("replayed", replayed))
+
+	return &taskpb.ReplayIndexJobsResponse{
+		Replayed: int32(replayed),
+	}, nil
+}
+
+func convertIndexerStatusToProto(status *searchindex.IndexerStatus) *taskpb.IndexerStatus {
+	return &taskpb.IndexerStatus{
+		Cursor:        unixOrZero(&status.Cursor),
+		LagSeconds:    int32(status.Lag / time.Second),
+		PendingJobs:   int32(status.Pending),
+		DeadJobs:      int32(status.Dead),
+		LastRunAt:     unixOrZero(status.LastRunAt),
+		LastSuccessAt: unixOrZero(status.LastSuccessAt),
+		LastError:     status.LastError,
+		LastErrorAt:   unixOrZero(status.LastErrorAt),
+		BreakerState:  status.BreakerState,
+	}
+}
+
+func convertIndexJobToProto(job *domain.IndexJob) *taskpb.IndexJob {
+	result := &taskpb.IndexJob{
+		Id:        job.ID,
+		TaskId:    job.TaskID,
+		Attempts:  int32(job.Attempts),
+		CreatedAt: int32(job.CreatedAt.Unix()),
+		UpdatedAt: int32(job.UpdatedAt.Unix()),
+	}
+	if job.LastError != nil {
+		result.LastError = *job.LastError
+	}
+	return result
+}
+
+// unixOrZero maps unset and pre-epoch times to 0.
+func unixOrZero(t *time.Time) int32 {
+	if t == nil || t.Unix() <= 0 {
+		return 0
+	}
+	return int32(t.Unix())
+}
@@ -2,7 +2,10 @@ package delivery
 
 import (
 	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+	"DobrikaDev/task-service/internal/service/notification"
+	"DobrikaDev/task-service/internal/service/searchindex"
 	"DobrikaDev/task-service/internal/service/task"
+	"DobrikaDev/task-service/internal/service/webhook"
 	"DobrikaDev/task-service/utils/config"
 	"context"
 
@@ -11,15 +14,41 @@ import (
 )
 
 type Server struct {
-	taskService *task.TaskService
+	taskService         *task.TaskService
+	webhookService      *webhook.WebhookService
+	notificationService *notification.NotificationService
+	searchIndexService  *searchindex.SearchIndexService
 	taskpb.UnimplementedTaskServiceServer
 
 	cfg    *config.Config
 	logger *zap.Logger
 }
 
-func NewServer(ctx context.Context, taskService *task.TaskService, cfg *config.Config, logger *zap.Logger) *Server {
+type ServerOption func(*Server)
+
+func WithWebhookService(webhookService *webhook.WebhookService) ServerOption {
+	return func(s *Server) {
+		s.webhookService = webhookService
+	}
+}
+
+func WithNotificationService(notificationService *notification.NotificationService) ServerOption {
+	return func(s *Server) {
+		s.notificationService = notificationService
+	}
+}
+
+func WithSearchIndexService(searchIndexService *searchindex.SearchIndexService) ServerOption {
+	return func(s *Server) {
+		s.searchIndexService = searchIndexService
+	}
+}
+
+func NewServer(ctx context.Context, taskService *task.TaskService, cfg *config.Config, logger *zap.Logger, opts ...ServerOption) *Server {
 	server := &Server{taskService: taskService, cfg: cfg, logger: logger}
+	for _, opt := range opts {
+		opt(server)
+	}
 	return server
 }
 
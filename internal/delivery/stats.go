@@ -0,0 +1,130 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+func (s *Server) GetCustomerStats(ctx context.Context, req *taskpb.GetCustomerStatsRequest) (*taskpb.GetCustomerStatsResponse, error) {
+	if req.GetCustomerId() == "" {
+		return &taskpb.GetCustomerStatsResponse{
+			Error: validationError("customer id is required"),
+		}, nil
+	}
+
+	from := unixToTime(req.GetWindow().GetFrom())
+	to := unixToTime(req.GetWindow().GetTo())
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return &taskpb.GetCustomerStatsResponse{
+			Error: validationError("window is empty or inverted"),
+		}, nil
+	}
+
+	stats, err := s.taskService.GetCustomerStats(ctx, req.GetCustomerId(), from, to, req.GetIncludeTasks())
+	if err != nil {
+		return &taskpb.GetCustomerStatsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("customer stats fetched", zap.String("customer_id", req.GetCustomerId()))
+
+	return &taskpb.GetCustomerStatsResponse{
+		Stats: convertCustomerStatsToProto(stats),
+	}, nil
+}
+
+func (s *Server) GetSearchAnalytics(ctx context.Context, req *taskpb.GetSearchAnalyticsRequest) (*taskpb.GetSearchAnalyticsResponse, error) {
+	if req.GetLimit() < 0 {
+		return &taskpb.GetSearchAnalyticsResponse{
+			Error: validationError("limit must not be negative"),
+		}, nil
+	}
+
+	from := unixToTime(req.GetWindow().GetFrom())
+	to := unixToTime(req.GetWindow().GetTo())
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return &taskpb.GetSearchAnalyticsResponse{
+			Error: validationError("window is empty or inverted"),
+		}, nil
+	}
+
+	analytics, err := s.taskService.GetSearchAnalytics(ctx, from, to, int(req.GetLimit()))
+	if err != nil {
+		return &taskpb.GetSearchAnalyticsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("search analytics fetched", zap.Int("searches", analytics.Searches))
+
+	return &taskpb.GetSearchAnalyticsResponse{
+		Analytics: convertSearchAnalyticsToProto(analytics),
+	}, nil
+}
+
+func convertCustomerStatsToProto(stats *domain.CustomerStats) *taskpb.CustomerStats {
+	return &taskpb.CustomerStats{
+		CustomerId:                 stats.CustomerID,
+		From:                       int32(stats.From.Unix()),
+		To:                         int32(stats.To.Unix()),
+		TotalTasks:                 int32(stats.TotalTasks),
+		ActiveTasks:                int32(stats.ActiveTasks),
+		Participations:             int32(stats.Participations),
+		UniqueParticipants:         int32(stats.UniqueParticipants),
+		Completed:                  int32(stats.Completed),
+		Approved:                   int32(stats.Approved),
+		Rejected:                   int32(stats.Rejected),
+		ApprovalRate:               stats.ApprovalRate,
+		AvgTimeToCompletionSeconds: int64(stats.AvgTimeToCompletion / time.Second),
+		PointsSpent:                int64(stats.PointsSpent),
+		Tasks:                      gospadi.Map(stats.Tasks, convertTaskStatsToProto),
+	}
+}
+
+func convertTaskStatsToProto(stats *domain.TaskStats) *taskpb.TaskStats {
+	return &taskpb.TaskStats{
+		TaskId:                     stats.TaskID,
+		Name:                       stats.Name,
+		Active:                     stats.Active,
+		Joined:                     int32(stats.Joined),
+		Completed:                  int32(stats.Completed),
+		Approved:                   int32(stats.Approved),
+		Rejected:                   int32(stats.Rejected),
+		ApprovalRate:               stats.ApprovalRate(),
+		AvgTimeToCompletionSeconds: int64(stats.AvgTimeToCompletion() / time.Second),
+		PointsSpent:                int64(stats.PointsSpent()),
+	}
+}
+
+func convertSearchAnalyticsToProto(analytics *domain.SearchAnalytics) *taskpb.SearchAnalytics {
+	return &taskpb.SearchAnalytics{
+		From:               int32(analytics.From.Unix()),
+		To:                 int32(analytics.To.Unix()),
+		Searches:           int32(analytics.Searches),
+		ZeroResultSearches: int32(analytics.ZeroResults),
+		ConvertedSearches:  int32(analytics.Converted),
+		Joins:              int32(analytics.Joins),
+		ConversionRate:     analytics.ConversionRate,
+		AvgLatencyMs:       analytics.AvgLatency.Milliseconds(),
+		TopQueries:         gospadi.Map(analytics.TopQueries, convertSearchQueryStatsToProto),
+		ZeroResultQueries:  gospadi.Map(analytics.ZeroResultQueries, convertSearchQueryStatsToProto),
+	}
+}
+
+func convertSearchQueryStatsToProto(stats *domain.SearchQueryStats) *taskpb.SearchQueryStats {
+	return &taskpb.SearchQueryStats{
+		Query:          stats.Query,
+		Searches:       int32(stats.Searches),
+		ZeroResults:    int32(stats.ZeroResults),
+		Converted:      int32(stats.Converted),
+		Joins:          int32(stats.Joins),
+		ConversionRate: stats.ConversionRate(),
+	}
+}
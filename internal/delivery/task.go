@@ -4,11 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"slices"
 	"strings"
+	"time"
 
 	"DobrikaDev/task-service/internal/domain"
 	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+	"DobrikaDev/task-service/internal/service/notification"
+	"DobrikaDev/task-service/internal/service/searchindex"
 	"DobrikaDev/task-service/internal/service/task"
+	"DobrikaDev/task-service/internal/service/webhook"
+	"DobrikaDev/task-service/internal/storage/sql"
 
 	"github.com/dr3dnought/gospadi"
 	"go.uber.org/zap"
@@ -21,39 +27,14 @@ func (s *Server) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequest)
 		}, nil
 	}
 
-	payload := req.GetTask()
-
-	if payload.CustomerId == "" {
+	task, validationErr := convertCreateTaskToDomain(req.GetTask())
+	if validationErr != nil {
 		return &taskpb.CreateTaskResponse{
-			Error: validationError("customer id is required"),
+			Error: validationErr,
 		}, nil
 	}
 
-	if payload.Name == "" {
-		return &taskpb.CreateTaskResponse{
-			Error: validationError("name is required"),
-		}, nil
-	}
-
-	metaJSON, err := convertProtoMetaToJSON(payload.Meta)
-	if err != nil {
-		return &taskpb.CreateTaskResponse{
-			Error: validationError("meta is invalid"),
-		}, nil
-	}
-
-	task := &domain.Task{
-		ID:               payload.Id,
-		CustomerID:       payload.CustomerId,
-		Name:             payload.Name,
-		Description:      payload.Description,
-		VerificationType: convertVerificationTypeToDomain(payload.VerificationType),
-		Cost:             int(payload.Cost),
-		MembersCount:     int(payload.MembersCount),
-		Meta:             metaJSON,
-	}
-
-	task, err = s.taskService.CreateTask(ctx, task)
+	task, err := s.taskService.CreateTask(ctx, task)
 	if err != nil {
 		return &taskpb.CreateTaskResponse{
 			Error: convertErrorToProto(err),
@@ -68,29 +49,60 @@ func (s *Server) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequest)
 }
 
 func (s *Server) GetTasks(ctx context.Context, req *taskpb.GetTasksRequest) (*taskpb.GetTasksResponse, error) {
-	tasks, count, err := s.taskService.GetTasks(ctx, req.GetCustomerId(), int(req.GetLimit()), int(req.GetOffset()))
+	options, validationErr := convertGetTasksRequestToOptions(req)
+	if validationErr != nil {
+		return &taskpb.GetTasksResponse{
+			Error: validationErr,
+		}, nil
+	}
+
+	page, err := s.taskService.GetTasks(ctx, options)
 	if err != nil {
 		return &taskpb.GetTasksResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
 
-	s.logger.Info("tasks fetched", zap.Int("count", count))
+	s.logger.Info("tasks fetched", zap.Int("count", len(page.Tasks)), zap.Int("total", page.Total))
 
 	return &taskpb.GetTasksResponse{
-		Tasks: gospadi.Map(tasks, convertTaskToProto),
-		Total: int32(count),
+		Tasks:         gospadi.Map(page.Tasks, convertTaskToProto),
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
 func (s *Server) SearchTasks(ctx context.Context, req *taskpb.SearchTasksRequest) (*taskpb.SearchTasksResponse, error) {
 	query := strings.TrimSpace(req.GetQuery())
 
-	tasks, err := s.taskService.SearchTasks(ctx, task.SearchOptions{
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &taskpb.SearchTasksResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+	if req.GetPageToken() != "" && req.GetOffset() > 0 {
+		return &taskpb.SearchTasksResponse{
+			Error: validationError("page token cannot be combined with offset"),
+		}, nil
+	}
+
+	filter, validationErr := convertSearchFilterToDomain(req.GetFilter())
+	if validationErr != nil {
+		return &taskpb.SearchTasksResponse{
+			Error: validationErr,
+		}, nil
+	}
+
+	page, err := s.taskService.SearchTasks(ctx, task.SearchOptions{
 		Query:     query,
 		QueryType: strings.TrimSpace(req.GetQueryType()),
 		GeoData:   strings.TrimSpace(req.GetGeoData()),
 		Tags:      req.GetTags(),
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+		PageToken: req.GetPageToken(),
+		Filter:    filter,
+		UserID:    strings.TrimSpace(req.GetUserId()),
 	})
 	if err != nil {
 		return &taskpb.SearchTasksResponse{
@@ -99,7 +111,10 @@ func (s *Server) SearchTasks(ctx context.Context, req *taskpb.SearchTasksRequest
 	}
 
 	return &taskpb.SearchTasksResponse{
-		Tasks: gospadi.Map(tasks, convertTaskToProto),
+		Tasks:         gospadi.Map(page.Tasks, convertTaskToProto),
+		Total:         int32(page.Total),
+		NextPageToken: page.NextPageToken,
+		SearchId:      page.SearchID,
 	}, nil
 }
 
@@ -131,33 +146,14 @@ func (s *Server) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequest)
 		}, nil
 	}
 
-	payload := req.GetTask()
-
-	if payload.Id == "" {
+	task, validationErr := convertUpdateTaskToDomain(req.GetTask())
+	if validationErr != nil {
 		return &taskpb.UpdateTaskResponse{
-			Error: validationError("id is required"),
+			Error: validationErr,
 		}, nil
 	}
 
-	metaJSON, err := convertProtoMetaToJSON(payload.Meta)
-	if err != nil {
-		return &taskpb.UpdateTaskResponse{
-			Error: validationError("meta is invalid"),
-		}, nil
-	}
-
-	task := &domain.Task{
-		ID:               payload.Id,
-		CustomerID:       payload.CustomerId,
-		Name:             payload.Name,
-		Description:      payload.Description,
-		VerificationType: convertVerificationTypeToDomain(payload.VerificationType),
-		Cost:             int(payload.Cost),
-		MembersCount:     int(payload.MembersCount),
-		Meta:             metaJSON,
-	}
-
-	task, err = s.taskService.UpdateTask(ctx, task)
+	task, err := s.taskService.UpdateTask(ctx, task)
 	if err != nil {
 		return &taskpb.UpdateTaskResponse{
 			Error: convertErrorToProto(err),
@@ -192,6 +188,163 @@ func (s *Server) DeleteTask(ctx context.Context, req *taskpb.DeleteTaskRequest)
 	}, nil
 }
 
+func convertCreateTaskToDomain(payload *taskpb.Task) (*domain.Task, *taskpb.Error) {
+	if payload.Id != "" && !domain.IsValidTaskID(payload.Id) {
+		return nil, validationError("id is invalid: expected up to 255 letters, digits, '.', '_', ':' or '-'")
+	}
+
+	if payload.CustomerId == "" {
+		return nil, validationError("customer id is required")
+	}
+
+	if payload.Name == "" {
+		return nil, validationError("name is required")
+	}
+
+	return convertProtoTaskToDomain(payload)
+}
+
+func convertUpdateTaskToDomain(payload *taskpb.Task) (*domain.Task, *taskpb.Error) {
+	if payload.Id == "" {
+		return nil, validationError("id is required")
+	}
+
+	return convertProtoTaskToDomain(payload)
+}
+
+func convertProtoTaskToDomain(payload *taskpb.Task) (*domain.Task, *taskpb.Error) {
+	metaJSON, err := convertProtoMetaToJSON(payload.Meta)
+	if err != nil {
+		return nil, validationError("meta is invalid")
+	}
+
+	return &domain.Task{
+		ID:               payload.Id,
+		CustomerID:       payload.CustomerId,
+		Name:             payload.Name,
+		Description:      payload.Description,
+		VerificationType: convertVerificationTypeToDomain(payload.VerificationType),
+		Cost:             int(payload.Cost),
+		MembersCount:     int(payload.MembersCount),
+		Meta:             metaJSON,
+	}, nil
+}
+
+func convertGetTasksRequestToOptions(req *taskpb.GetTasksRequest) (task.GetTasksOptions, *taskpb.Error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return task.GetTasksOptions{}, validationError("limit and offset must not be negative")
+	}
+	if req.GetPageToken() != "" && req.GetOffset() > 0 {
+		return task.GetTasksOptions{}, validationError("page_token cannot be combined with offset")
+	}
+
+	pbFilter := req.GetFilter()
+
+	filter := task.TaskFilter{
+		IDs:               pbFilter.GetIds(),
+		CustomerIDs:       pbFilter.GetCustomerIds(),
+		Name:              strings.TrimSpace(pbFilter.GetName()),
+		NameLike:          strings.TrimSpace(pbFilter.GetNameLike()),
+		VerificationTypes: gospadi.Map(pbFilter.GetVerificationTypes(), convertVerificationTypeToDomain),
+		CreatedFrom:       unixToTime(pbFilter.GetCreated().GetFrom()),
+		CreatedTo:         unixToTime(pbFilter.GetCreated().GetTo()),
+		UpdatedFrom:       unixToTime(pbFilter.GetUpdated().GetFrom()),
+		UpdatedTo:         unixToTime(pbFilter.GetUpdated().GetTo()),
+	}
+
+	// The legacy customer_id narrows filter.customer_ids rather than adding
+	// to it; a customer outside that list could match nothing.
+	if customerID := req.GetCustomerId(); customerID != "" {
+		if len(filter.CustomerIDs) > 0 && !slices.Contains(filter.CustomerIDs, customerID) {
+			return task.GetTasksOptions{}, validationError("customer_id is not among filter.customer_ids")
+		}
+		filter.CustomerIDs = []string{customerID}
+	}
+
+	if pbFilter.CostMin != nil {
+		costMin := int(pbFilter.GetCostMin())
+		filter.CostMin = &costMin
+	}
+	if pbFilter.CostMax != nil {
+		costMax := int(pbFilter.GetCostMax())
+		filter.CostMax = &costMax
+	}
+	if filter.CostMin != nil && filter.CostMax != nil && *filter.CostMin > *filter.CostMax {
+		return task.GetTasksOptions{}, validationError("cost_min must not exceed cost_max")
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return task.GetTasksOptions{}, validationError("created range is inverted")
+	}
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && filter.UpdatedFrom.After(filter.UpdatedTo) {
+		return task.GetTasksOptions{}, validationError("updated range is inverted")
+	}
+
+	return task.GetTasksOptions{
+		Filter:    filter,
+		SortField: convertTaskSortFieldToDomain(req.GetSortField()),
+		SortDesc:  req.GetSortDirection() != taskpb.SortDirection_SORT_DIRECTION_ASC,
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+		PageToken: req.GetPageToken(),
+		SkipTotal: req.GetSkipTotal(),
+	}, nil
+}
+
+func convertSearchFilterToDomain(pbFilter *taskpb.SearchFilter) (task.SearchFilter, *taskpb.Error) {
+	filter := task.SearchFilter{
+		CustomerIDs:       pbFilter.GetCustomerIds(),
+		VerificationTypes: gospadi.Map(pbFilter.GetVerificationTypes(), convertVerificationTypeToDomain),
+		MinOpenSlots:      int(pbFilter.GetMinOpenSlots()),
+		CreatedFrom:       unixToTime(pbFilter.GetCreated().GetFrom()),
+		CreatedTo:         unixToTime(pbFilter.GetCreated().GetTo()),
+		UpdatedFrom:       unixToTime(pbFilter.GetUpdated().GetFrom()),
+		UpdatedTo:         unixToTime(pbFilter.GetUpdated().GetTo()),
+	}
+
+	if pbFilter.CostMin != nil {
+		costMin := int(pbFilter.GetCostMin())
+		filter.CostMin = &costMin
+	}
+	if pbFilter.CostMax != nil {
+		costMax := int(pbFilter.GetCostMax())
+		filter.CostMax = &costMax
+	}
+	if filter.CostMin != nil && filter.CostMax != nil && *filter.CostMin > *filter.CostMax {
+		return task.SearchFilter{}, validationError("cost_min must not exceed cost_max")
+	}
+	if filter.MinOpenSlots < 0 {
+		return task.SearchFilter{}, validationError("min_open_slots must not be negative")
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return task.SearchFilter{}, validationError("created range is inverted")
+	}
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && filter.UpdatedFrom.After(filter.UpdatedTo) {
+		return task.SearchFilter{}, validationError("updated range is inverted")
+	}
+
+	return filter, nil
+}
+
+func convertTaskSortFieldToDomain(field taskpb.TaskSortField) sql.TaskSortField {
+	switch field {
+	case taskpb.TaskSortField_TASK_SORT_FIELD_UPDATED_AT:
+		return sql.TaskSortUpdatedAt
+	case taskpb.TaskSortField_TASK_SORT_FIELD_COST:
+		return sql.TaskSortCost
+	case taskpb.TaskSortField_TASK_SORT_FIELD_NAME:
+		return sql.TaskSortName
+	default:
+		return sql.TaskSortCreatedAt
+	}
+}
+
+func unixToTime(seconds int32) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(seconds), 0).UTC()
+}
+
 func convertTaskToProto(task *domain.Task) *taskpb.Task {
 	meta := convertTaskMetaToProto(task.Meta)
 
@@ -206,6 +359,13 @@ func convertTaskToProto(task *domain.Task) *taskpb.Task {
 		Meta:             meta,
 		CreatedAt:        int32(task.CreatedAt.Unix()),
 		UpdatedAt:        int32(task.UpdatedAt.Unix()),
+		Participants: &taskpb.ParticipantCounters{
+			Pending:   int32(task.ParticipantsPending),
+			Completed: int32(task.ParticipantsCompleted),
+			Approved:  int32(task.ParticipantsApproved),
+			Rejected:  int32(task.ParticipantsRejected),
+			Cancelled: int32(task.ParticipantsCancelled),
+		},
 	}
 }
 
@@ -323,6 +483,21 @@ func convertErrorToProto(err error) *taskpb.Error {
 			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
 			Message: err.Error(),
 		}
+	case errors.Is(err, task.ErrWatchUnavailable):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
+			Message: err.Error(),
+		}
+	case errors.Is(err, task.ErrWatchEventExpired):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_EXPIRED,
+			Message: err.Error(),
+		}
+	case errors.Is(err, task.ErrWatchEventInvalid):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
 	case errors.Is(err, task.ErrUserTaskNotFound):
 		return &taskpb.Error{
 			Code:    taskpb.ErrorCode_ERROR_CODE_NOT_FOUND,
@@ -343,6 +518,41 @@ func convertErrorToProto(err error) *taskpb.Error {
 			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
 			Message: err.Error(),
 		}
+	case errors.Is(err, webhook.ErrWebhookNotFound), errors.Is(err, webhook.ErrWebhookDeliveryNotFound):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_NOT_FOUND,
+			Message: err.Error(),
+		}
+	case errors.Is(err, webhook.ErrWebhookInvalid):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case errors.Is(err, webhook.ErrWebhookInternal):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
+			Message: err.Error(),
+		}
+	case errors.Is(err, notification.ErrNotificationInvalid):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case errors.Is(err, notification.ErrNotificationInternal):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
+			Message: err.Error(),
+		}
+	case errors.Is(err, searchindex.ErrReindexInvalid):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case errors.Is(err, searchindex.ErrReindexInternal), errors.Is(err, searchindex.ErrSearchIndexUnavailable):
+		return &taskpb.Error{
+			Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
+			Message: err.Error(),
+		}
 	default:
 		return &taskpb.Error{
 			Code:    taskpb.ErrorCode_ERROR_CODE_UNSPECIFIED,
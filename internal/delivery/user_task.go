@@ -4,10 +4,20 @@ import (
 	"DobrikaDev/task-service/internal/domain"
 	"DobrikaDev/task-service/internal/generated/proto/task"
 	"context"
+
+	"github.com/dr3dnought/gospadi"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 func (s *Server) UserJoinTask(ctx context.Context, req *task.UserJoinTaskRequest) (*task.UserJoinTaskResponse, error) {
-	_, err := s.taskService.UserJoinTask(ctx, req.UserId, req.TaskId)
+	if req.GetSearchId() != "" && uuid.Validate(req.GetSearchId()) != nil {
+		return &task.UserJoinTaskResponse{
+			Error: validationError("search id is malformed"),
+		}, nil
+	}
+
+	_, err := s.taskService.UserJoinTask(ctx, req.UserId, req.TaskId, req.GetSearchId())
 	if err != nil {
 		return &task.UserJoinTaskResponse{
 			Error: convertErrorToProto(err),
@@ -65,3 +75,122 @@ func (s *Server) RejectTask(ctx context.Context, req *task.RejectTaskRequest) (*
 		Error: convertErrorToProto(err),
 	}, nil
 }
+
+func (s *Server) ListUserTasks(ctx context.Context, req *task.ListUserTasksRequest) (*task.ListUserTasksResponse, error) {
+	if req.GetUserId() == "" {
+		return &task.ListUserTasksResponse{
+			Error: validationError("user id is required"),
+		}, nil
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &task.ListUserTasksResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+
+	items, count, err := s.taskService.ListUserTasks(
+		ctx,
+		req.GetUserId(),
+		gospadi.Map(req.GetStatuses(), convertUserTaskStatusToDomain),
+		int(req.GetLimit()),
+		int(req.GetOffset()),
+	)
+	if err != nil {
+		return &task.ListUserTasksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	userTasks := make([]*task.UserTask, 0, len(items))
+	for _, item := range items {
+		userTask := convertUserTaskToProto(item.UserTask)
+		if item.Task != nil {
+			userTask.Task = convertTaskToProto(item.Task)
+		}
+		userTasks = append(userTasks, userTask)
+	}
+
+	s.logger.Info("user tasks fetched", zap.String("user_id", req.GetUserId()), zap.Int("count", count))
+
+	return &task.ListUserTasksResponse{
+		UserTasks: userTasks,
+		Total:     int32(count),
+	}, nil
+}
+
+func (s *Server) ListTaskParticipants(ctx context.Context, req *task.ListTaskParticipantsRequest) (*task.ListTaskParticipantsResponse, error) {
+	if req.GetTaskId() == "" {
+		return &task.ListTaskParticipantsResponse{
+			Error: validationError("task id is required"),
+		}, nil
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &task.ListTaskParticipantsResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+
+	participants, count, err := s.taskService.ListTaskParticipants(
+		ctx,
+		req.GetTaskId(),
+		gospadi.Map(req.GetStatuses(), convertUserTaskStatusToDomain),
+		int(req.GetLimit()),
+		int(req.GetOffset()),
+	)
+	if err != nil {
+		return &task.ListTaskParticipantsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("task participants fetched", zap.String("task_id", req.GetTaskId()), zap.Int("count", count))
+
+	return &task.ListTaskParticipantsResponse{
+		Participants: gospadi.Map(participants, convertUserTaskToProto),
+		Total:        int32(count),
+	}, nil
+}
+
+func convertUserTaskToProto(userTask *domain.UserTask) *task.UserTask {
+	return &task.UserTask{
+		UserId:    userTask.UserID,
+		TaskId:    userTask.TaskID,
+		Status:    convertUserTaskStatusToProto(userTask.Status),
+		CreatedAt: int32(userTask.CreatedAt.Unix()),
+		UpdatedAt: int32(userTask.UpdatedAt.Unix()),
+	}
+}
+
+func convertUserTaskStatusToDomain(status task.UserTaskStatus) domain.Status {
+	switch status {
+	case task.UserTaskStatus_USER_TASK_STATUS_PENDING:
+		return domain.StatusInProgress
+	case task.UserTaskStatus_USER_TASK_STATUS_COMPLETED:
+		return domain.StatusCompleted
+	case task.UserTaskStatus_USER_TASK_STATUS_APPROVED:
+		return domain.StatusApproved
+	case task.UserTaskStatus_USER_TASK_STATUS_REJECTED:
+		return domain.StatusRejected
+	case task.UserTaskStatus_USER_TASK_STATUS_CANCELLED:
+		return domain.StatusCancelled
+	default:
+		return domain.Status("")
+	}
+}
+
+func convertUserTaskStatusToProto(status domain.Status) task.UserTaskStatus {
+	switch status {
+	case domain.StatusInProgress:
+		return task.UserTaskStatus_USER_TASK_STATUS_PENDING
+	case domain.StatusCompleted:
+		return task.UserTaskStatus_USER_TASK_STATUS_COMPLETED
+	case domain.StatusApproved:
+		return task.UserTaskStatus_USER_TASK_STATUS_APPROVED
+	case domain.StatusRejected:
+		return task.UserTaskStatus_USER_TASK_STATUS_REJECTED
+	case domain.StatusCancelled:
+		return task.UserTaskStatus_USER_TASK_STATUS_CANCELLED
+	default:
+		return task.UserTaskStatus_USER_TASK_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,92 @@
+package delivery
+
+import (
+	"DobrikaDev/task-service/internal/broker"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) WatchTask(req *taskpb.WatchTaskRequest, stream grpc.ServerStreamingServer[taskpb.WatchEvent]) error {
+	if req.GetTaskId() == "" {
+		return stream.Send(&taskpb.WatchEvent{Error: validationError("task id is required")})
+	}
+
+	events, err := s.taskService.WatchTask(stream.Context(), req.GetTaskId(), req.GetAfterEventId())
+	if err != nil {
+		return stream.Send(&taskpb.WatchEvent{Error: convertErrorToProto(err)})
+	}
+
+	s.logger.Info("task watch started", zap.String("task_id", req.GetTaskId()))
+	defer s.logger.Info("task watch finished", zap.String("task_id", req.GetTaskId()))
+
+	return forwardEvents(stream, events)
+}
+
+func (s *Server) WatchUserTasks(req *taskpb.WatchUserTasksRequest, stream grpc.ServerStreamingServer[taskpb.WatchEvent]) error {
+	if req.GetUserId() == "" {
+		return stream.Send(&taskpb.WatchEvent{Error: validationError("user id is required")})
+	}
+
+	events, err := s.taskService.WatchUserTasks(stream.Context(), req.GetUserId(), req.GetAfterEventId())
+	if err != nil {
+		return stream.Send(&taskpb.WatchEvent{Error: convertErrorToProto(err)})
+	}
+
+	s.logger.Info("user tasks watch started", zap.String("user_id", req.GetUserId()))
+	defer s.logger.Info("user tasks watch finished", zap.String("user_id", req.GetUserId()))
+
+	return forwardEvents(stream, events)
+}
+
+// forwardEvents pushes events until the broker closes the channel, which
+// happens on client cancellation, slow consumption and server shutdown. In
+// the last two cases the client is still listening, so the stream ends with
+// Aborted to tell it to resubscribe from the last event id it received.
+func forwardEvents(stream grpc.ServerStreamingServer[taskpb.WatchEvent], events <-chan broker.Event) error {
+	for event := range events {
+		if err := stream.Send(convertEventToProto(event)); err != nil {
+			return err
+		}
+	}
+	if err := stream.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Aborted, "watch stream dropped by the server; resubscribe with after_event_id")
+}
+
+func convertEventToProto(event broker.Event) *taskpb.WatchEvent {
+	result := &taskpb.WatchEvent{
+		EventId:    event.ID,
+		Type:       convertEventTypeToProto(event.Type),
+		OccurredAt: int32(event.OccurredAt.Unix()),
+		TaskId:     event.TaskID,
+	}
+	if event.Task != nil {
+		result.Task = convertTaskToProto(event.Task)
+	}
+	if event.UserTask != nil {
+		result.UserTask = convertUserTaskToProto(event.UserTask)
+	}
+	return result
+}
+
+func convertEventTypeToProto(eventType broker.EventType) taskpb.WatchEventType {
+	switch eventType {
+	case broker.EventTaskCreated:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_TASK_CREATED
+	case broker.EventTaskUpdated:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_TASK_UPDATED
+	case broker.EventTaskDeleted:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_TASK_DELETED
+	case broker.EventUserTaskCreated:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_USER_TASK_CREATED
+	case broker.EventUserTaskUpdated:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_USER_TASK_UPDATED
+	default:
+		return taskpb.WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,291 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	"DobrikaDev/task-service/internal/domain"
+	taskpb "DobrikaDev/task-service/internal/generated/proto/task"
+	"DobrikaDev/task-service/internal/service/webhook"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+var errWebhooksDisabled = errors.New("webhooks are not configured")
+
+func (s *Server) CreateWebhook(ctx context.Context, req *taskpb.CreateWebhookRequest) (*taskpb.CreateWebhookResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.CreateWebhookResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetCustomerId() == "" || req.GetUrl() == "" {
+		return &taskpb.CreateWebhookResponse{
+			Error: validationError("customer id and url are required"),
+		}, nil
+	}
+
+	eventTypes, ok := convertWebhookEventTypesToDomain(req.GetEventTypes())
+	if !ok {
+		return &taskpb.CreateWebhookResponse{
+			Error: validationError("unknown webhook event type"),
+		}, nil
+	}
+
+	subscription, err := s.webhookService.CreateWebhook(ctx, req.GetCustomerId(), req.GetUrl(), req.GetSecret(), eventTypes)
+	if err != nil {
+		return &taskpb.CreateWebhookResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("webhook created", zap.String("id", subscription.ID), zap.String("customer_id", subscription.CustomerID))
+
+	webhookProto := convertWebhookToProto(subscription)
+	webhookProto.Secret = subscription.Secret
+
+	return &taskpb.CreateWebhookResponse{
+		Webhook: webhookProto,
+	}, nil
+}
+
+func (s *Server) UpdateWebhook(ctx context.Context, req *taskpb.UpdateWebhookRequest) (*taskpb.UpdateWebhookResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.UpdateWebhookResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetId() == "" || req.GetCustomerId() == "" {
+		return &taskpb.UpdateWebhookResponse{
+			Error: validationError("id and customer id are required"),
+		}, nil
+	}
+
+	options := webhook.UpdateWebhookOptions{
+		URL:    req.Url,
+		Active: req.Active,
+	}
+	if req.GetUpdateEventTypes() {
+		eventTypes, ok := convertWebhookEventTypesToDomain(req.GetEventTypes())
+		if !ok {
+			return &taskpb.UpdateWebhookResponse{
+				Error: validationError("unknown webhook event type"),
+			}, nil
+		}
+		options.EventTypes = eventTypes
+	}
+
+	subscription, err := s.webhookService.UpdateWebhook(ctx, req.GetId(), req.GetCustomerId(), options)
+	if err != nil {
+		return &taskpb.UpdateWebhookResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("webhook updated", zap.String("id", subscription.ID))
+
+	return &taskpb.UpdateWebhookResponse{
+		Webhook: convertWebhookToProto(subscription),
+	}, nil
+}
+
+func (s *Server) ListWebhooks(ctx context.Context, req *taskpb.ListWebhooksRequest) (*taskpb.ListWebhooksResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.ListWebhooksResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetCustomerId() == "" {
+		return &taskpb.ListWebhooksResponse{
+			Error: validationError("customer id is required"),
+		}, nil
+	}
+
+	subscriptions, err := s.webhookService.ListWebhooks(ctx, req.GetCustomerId())
+	if err != nil {
+		return &taskpb.ListWebhooksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.ListWebhooksResponse{
+		Webhooks: gospadi.Map(subscriptions, convertWebhookToProto),
+	}, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *taskpb.DeleteWebhookRequest) (*taskpb.DeleteWebhookResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.DeleteWebhookResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetId() == "" || req.GetCustomerId() == "" {
+		return &taskpb.DeleteWebhookResponse{
+			Error: validationError("id and customer id are required"),
+		}, nil
+	}
+
+	if err := s.webhookService.DeleteWebhook(ctx, req.GetId(), req.GetCustomerId()); err != nil {
+		return &taskpb.DeleteWebhookResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("webhook deleted", zap.String("id", req.GetId()))
+
+	return &taskpb.DeleteWebhookResponse{}, nil
+}
+
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req *taskpb.ListWebhookDeliveriesRequest) (*taskpb.ListWebhookDeliveriesResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.ListWebhookDeliveriesResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetWebhookId() == "" || req.GetCustomerId() == "" {
+		return &taskpb.ListWebhookDeliveriesResponse{
+			Error: validationError("webhook id and customer id are required"),
+		}, nil
+	}
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return &taskpb.ListWebhookDeliveriesResponse{
+			Error: validationError("limit and offset must not be negative"),
+		}, nil
+	}
+
+	deliveries, total, err := s.webhookService.ListWebhookDeliveries(
+		ctx,
+		req.GetWebhookId(),
+		req.GetCustomerId(),
+		int(req.GetLimit()),
+		int(req.GetOffset()),
+	)
+	if err != nil {
+		return &taskpb.ListWebhookDeliveriesResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	return &taskpb.ListWebhookDeliveriesResponse{
+		Deliveries: gospadi.Map(deliveries, convertWebhookDeliveryToProto),
+		Total:      int32(total),
+	}, nil
+}
+
+func (s *Server) RedeliverWebhook(ctx context.Context, req *taskpb.RedeliverWebhookRequest) (*taskpb.RedeliverWebhookResponse, error) {
+	if s.webhookService == nil {
+		return &taskpb.RedeliverWebhookResponse{Error: internalError(errWebhooksDisabled)}, nil
+	}
+	if req.GetDeliveryId() <= 0 || req.GetCustomerId() == "" {
+		return &taskpb.RedeliverWebhookResponse{
+			Error: validationError("delivery id and customer id are required"),
+		}, nil
+	}
+
+	delivery, err := s.webhookService.RedeliverWebhook(ctx, req.GetDeliveryId(), req.GetCustomerId())
+	if err != nil {
+		return &taskpb.RedeliverWebhookResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+
+	s.logger.Info("webhook redelivery queued", zap.Int64("delivery_id", delivery.ID))
+
+	return &taskpb.RedeliverWebhookResponse{
+		Delivery: convertWebhookDeliveryToProto(delivery),
+	}, nil
+}
+
+func internalError(err error) *taskpb.Error {
+	return &taskpb.Error{
+		Code:    taskpb.ErrorCode_ERROR_CODE_INTERNAL,
+		Message: err.Error(),
+	}
+}
+
+// convertWebhookToProto leaves the secret out; only CreateWebhook returns it.
+func convertWebhookToProto(subscription *domain.WebhookSubscription) *taskpb.Webhook {
+	return &taskpb.Webhook{
+		Id:         subscription.ID,
+		CustomerId: subscription.CustomerID,
+		Url:        subscription.URL,
+		EventTypes: gospadi.Map(subscription.Events(), convertWebhookEventTypeToProto),
+		Active:     subscription.Active,
+		CreatedAt:  int32(subscription.CreatedAt.Unix()),
+		UpdatedAt:  int32(subscription.UpdatedAt.Unix()),
+	}
+}
+
+func convertWebhookDeliveryToProto(delivery *domain.WebhookDelivery) *taskpb.WebhookDelivery {
+	result := &taskpb.WebhookDelivery{
+		Id:            delivery.ID,
+		WebhookId:     delivery.SubscriptionID,
+		EventId:       delivery.EventID,
+		EventType:     convertWebhookEventTypeToProto(delivery.EventType),
+		Payload:       string(delivery.Payload),
+		Status:        convertWebhookDeliveryStatusToProto(delivery.Status),
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: int32(delivery.NextAttemptAt.Unix()),
+		CreatedAt:     int32(delivery.CreatedAt.Unix()),
+	}
+	if delivery.LastStatusCode != nil {
+		result.LastStatusCode = int32(*delivery.LastStatusCode)
+	}
+	if delivery.LastError != nil {
+		result.LastError = *delivery.LastError
+	}
+	if delivery.DeliveredAt != nil {
+		result.DeliveredAt = int32(delivery.DeliveredAt.Unix())
+	}
+	return result
+}
+
+func convertWebhookEventTypesToDomain(eventTypes []taskpb.WebhookEventType) ([]domain.WebhookEventType, bool) {
+	result := make([]domain.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		converted, ok := convertWebhookEventTypeToDomain(eventType)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, converted)
+	}
+	return result, true
+}
+
+func convertWebhookEventTypeToDomain(eventType taskpb.WebhookEventType) (domain.WebhookEventType, bool) {
+	switch eventType {
+	case taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED:
+		return domain.WebhookParticipantJoined, true
+	case taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED:
+		return domain.WebhookParticipantConfirmed, true
+	case taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED:
+		return domain.WebhookParticipantApproved, true
+	case taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED:
+		return domain.WebhookParticipantRejected, true
+	case taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT:
+		return domain.WebhookParticipantLeft, true
+	default:
+		return "", false
+	}
+}
+
+func convertWebhookEventTypeToProto(eventType domain.WebhookEventType) taskpb.WebhookEventType {
+	switch eventType {
+	case domain.WebhookParticipantJoined:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED
+	case domain.WebhookParticipantConfirmed:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED
+	case domain.WebhookParticipantApproved:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED
+	case domain.WebhookParticipantRejected:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED
+	case domain.WebhookParticipantLeft:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT
+	default:
+		return taskpb.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func convertWebhookDeliveryStatusToProto(status domain.WebhookDeliveryStatus) taskpb.WebhookDeliveryStatus {
+	switch status {
+	case domain.WebhookDeliveryPending:
+		return taskpb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case domain.WebhookDeliverySucceeded:
+		return taskpb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case domain.WebhookDeliveryFailed:
+		return taskpb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return taskpb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
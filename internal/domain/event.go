@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+type EventType string
+
+const (
+	EventTaskCreated           EventType = "task.created"
+	EventTaskUpdated           EventType = "task.updated"
+	EventTaskDeleted           EventType = "task.deleted"
+	EventUserTaskCreated       EventType = "user_task.created"
+	EventUserTaskStatusChanged EventType = "user_task.status_changed"
+)
+
+func (e EventType) String() string {
+	return string(e)
+}
+
+// OutboxEvent is a domain event stored in the same transaction as the change
+// it describes. Payload holds a serialized events.Envelope.
+type OutboxEvent struct {
+	ID            int64      `json:"id" db:"id"`
+	EventID       string     `json:"event_id" db:"event_id"`
+	EventType     EventType  `json:"event_type" db:"event_type"`
+	AggregateID   string     `json:"aggregate_id" db:"aggregate_id"`
+	SchemaVersion int        `json:"schema_version" db:"schema_version"`
+	Payload       []byte     `json:"payload" db:"payload"`
+	Attempts      int        `json:"attempts" db:"attempts"`
+	LastError     *string    `json:"last_error" db:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" db:"next_attempt_at"`
+	PublishedAt   *time.Time `json:"published_at" db:"published_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+}
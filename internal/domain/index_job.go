@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+type IndexJobStatus string
+
+const (
+	IndexJobPending IndexJobStatus = "pending"
+	// IndexJobDead marks a job that ran out of attempts. It stays in the
+	// queue for inspection and is not picked up again.
+	IndexJobDead IndexJobStatus = "dead"
+)
+
+func (s IndexJobStatus) String() string {
+	return string(s)
+}
+
+// IndexJob asks the indexer to bring the search engine in line with the
+// current state of a task: index it, or remove it when it is gone or closed.
+type IndexJob struct {
+	ID            int64          `json:"id" db:"id"`
+	TaskID        string         `json:"task_id" db:"task_id"`
+	Status        IndexJobStatus `json:"status" db:"status"`
+	Attempts      int            `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time      `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError     *string        `json:"last_error" db:"last_error"`
+	CreatedAt     time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// SearchCursor is the position of the indexer sweep: every task ordered at
+// or before (UpdatedAt, ID) has been synced.
+type SearchCursor struct {
+	UpdatedAt time.Time `db:"last_synced_at"`
+	ID        string    `db:"last_synced_id"`
+}
+
+// IndexerState is the indexer bookkeeping kept in search_index_state.
+type IndexerState struct {
+	SearchCursor
+	LastRunAt     *time.Time `db:"last_run_at"`
+	LastSuccessAt *time.Time `db:"last_success_at"`
+	LastError     *string    `db:"last_error"`
+	LastErrorAt   *time.Time `db:"last_error_at"`
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	// Sent to the volunteer.
+	NotificationTaskApproved NotificationType = "task.approved"
+	NotificationTaskRejected NotificationType = "task.rejected"
+
+	// Sent to the customer owning the task.
+	NotificationParticipantJoined    NotificationType = "participant.joined"
+	NotificationParticipantConfirmed NotificationType = "participant.confirmed"
+)
+
+func (t NotificationType) String() string {
+	return string(t)
+}
+
+// NotificationChannel is an external channel a notification is pushed to.
+// Every notification is also kept in the in-app inbox regardless of channels.
+type NotificationChannel string
+
+const (
+	NotificationChannelPush  NotificationChannel = "push"
+	NotificationChannelEmail NotificationChannel = "email"
+)
+
+var NotificationChannels = []NotificationChannel{
+	NotificationChannelPush,
+	NotificationChannelEmail,
+}
+
+func (c NotificationChannel) String() string {
+	return string(c)
+}
+
+func (c NotificationChannel) Valid() bool {
+	return slices.Contains(NotificationChannels, c)
+}
+
+type Notification struct {
+	ID          string           `json:"id" db:"id"`
+	RecipientID string           `json:"recipient_id" db:"recipient_id"`
+	EventID     string           `json:"event_id" db:"event_id"`
+	Type        NotificationType `json:"type" db:"type"`
+	TaskID      string           `json:"task_id" db:"task_id"`
+	Title       string           `json:"title" db:"title"`
+	Body        string           `json:"body" db:"body"`
+	Channels    string           `json:"channels" db:"channels"`
+	SendAfter   time.Time        `json:"send_after" db:"send_after"`
+	Attempts    int              `json:"attempts" db:"attempts"`
+	LastError   *string          `json:"last_error" db:"last_error"`
+	SentAt      *time.Time       `json:"sent_at" db:"sent_at"`
+	ReadAt      *time.Time       `json:"read_at" db:"read_at"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+}
+
+func (n *Notification) ChannelList() []NotificationChannel {
+	return splitChannels(n.Channels)
+}
+
+// NotificationPreferences are a user's delivery settings. Quiet hours are
+// minutes after local midnight in Timezone; a window may wrap past midnight.
+type NotificationPreferences struct {
+	UserID          string    `json:"user_id" db:"user_id"`
+	Channels        string    `json:"channels" db:"channels"`
+	QuietHoursStart *int      `json:"quiet_hours_start" db:"quiet_hours_start"`
+	QuietHoursEnd   *int      `json:"quiet_hours_end" db:"quiet_hours_end"`
+	Timezone        string    `json:"timezone" db:"timezone"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// DefaultNotificationPreferences applies to users who never saved settings.
+func DefaultNotificationPreferences(userID string) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:   userID,
+		Channels: NotificationChannelPush.String(),
+		Timezone: "UTC",
+	}
+}
+
+func (p *NotificationPreferences) ChannelList() []NotificationChannel {
+	return splitChannels(p.Channels)
+}
+
+func (p *NotificationPreferences) SetChannels(channels []NotificationChannel) {
+	items := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		items = append(items, channel.String())
+	}
+	p.Channels = strings.Join(items, ",")
+}
+
+// NextSendTime returns now, or the end of the quiet hours window when now
+// falls inside it.
+func (p *NotificationPreferences) NextSendTime(now time.Time) time.Time {
+	if p.QuietHoursStart == nil || p.QuietHoursEnd == nil || *p.QuietHoursStart == *p.QuietHoursEnd {
+		return now
+	}
+
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	minute := local.Hour()*60 + local.Minute()
+	start, end := *p.QuietHoursStart, *p.QuietHoursEnd
+
+	switch {
+	case start < end && minute >= start && minute < end:
+		return midnight.Add(time.Duration(end) * time.Minute)
+	case start > end && minute >= start:
+		return midnight.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute)
+	case start > end && minute < end:
+		return midnight.Add(time.Duration(end) * time.Minute)
+	default:
+		return now
+	}
+}
+
+func splitChannels(value string) []NotificationChannel {
+	result := make([]NotificationChannel, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, NotificationChannel(item))
+		}
+	}
+	return result
+}
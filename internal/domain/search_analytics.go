@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SearchEvent is one logged SearchTasks call.
+type SearchEvent struct {
+	ID     string
+	UserID string
+	// Query is normalised: lower case with single spaces.
+	Query     string
+	QueryType string
+	Filters   json.RawMessage
+	ResultIDs []string
+	Total     int
+	Latency   time.Duration
+	CreatedAt time.Time
+}
+
+// SearchResultPage holds the ids of a further page of a logged search.
+type SearchResultPage struct {
+	SearchID  string
+	ResultIDs []string
+}
+
+// SearchJoin attributes a UserJoinTask call to the search that produced it.
+type SearchJoin struct {
+	SearchID  string
+	UserID    string
+	TaskID    string
+	CreatedAt time.Time
+}
+
+// SearchQueryStats aggregates the searches for one normalised query.
+type SearchQueryStats struct {
+	Query       string `json:"query" db:"query"`
+	Searches    int    `json:"searches" db:"searches"`
+	ZeroResults int    `json:"zero_results" db:"zero_results"`
+	// Converted counts searches that led to at least one join.
+	Converted int `json:"converted" db:"converted"`
+	Joins     int `json:"joins" db:"joins"`
+}
+
+// ConversionRate is converted / searches, or 0 without searches.
+func (s SearchQueryStats) ConversionRate() float64 {
+	return conversionRate(s.Converted, s.Searches)
+}
+
+// SearchTotals aggregates every search inside a window.
+type SearchTotals struct {
+	Searches     int     `db:"searches"`
+	ZeroResults  int     `db:"zero_results"`
+	Converted    int     `db:"converted"`
+	Joins        int     `db:"joins"`
+	AvgLatencyMs float64 `db:"avg_latency_ms"`
+}
+
+type SearchAnalytics struct {
+	From        time.Time
+	To          time.Time
+	Searches    int
+	ZeroResults int
+	Converted   int
+	Joins       int
+	// ConversionRate is converted / searches, or 0 without searches.
+	ConversionRate    float64
+	AvgLatency        time.Duration
+	TopQueries        []*SearchQueryStats
+	ZeroResultQueries []*SearchQueryStats
+}
+
+func NewSearchAnalytics(from, to time.Time, totals SearchTotals, top, zeroResult []*SearchQueryStats) *SearchAnalytics {
+	return &SearchAnalytics{
+		From:              from,
+		To:                to,
+		Searches:          totals.Searches,
+		ZeroResults:       totals.ZeroResults,
+		Converted:         totals.Converted,
+		Joins:             totals.Joins,
+		ConversionRate:    conversionRate(totals.Converted, totals.Searches),
+		AvgLatency:        time.Duration(totals.AvgLatencyMs * float64(time.Millisecond)),
+		TopQueries:        top,
+		ZeroResultQueries: zeroResult,
+	}
+}
+
+func conversionRate(converted, searches int) float64 {
+	if searches == 0 {
+		return 0
+	}
+	return float64(converted) / float64(searches)
+}
@@ -0,0 +1,9 @@
+package domain
+
+// SearchCacheInvalidation tells every replica to drop cached search results
+// that mention TaskID. IDs increase, so a replica only reads the ones after
+// the last it applied.
+type SearchCacheInvalidation struct {
+	ID     int64  `db:"id"`
+	TaskID string `db:"task_id"`
+}
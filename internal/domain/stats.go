@@ -0,0 +1,90 @@
+package domain
+
+import "time"
+
+// TaskStats aggregates the participations of one task inside a stats window.
+type TaskStats struct {
+	TaskID                 string  `json:"task_id" db:"task_id"`
+	Name                   string  `json:"name" db:"name"`
+	Cost                   int     `json:"cost" db:"cost"`
+	Active                 bool    `json:"active" db:"active"`
+	Joined                 int     `json:"joined" db:"joined"`
+	Completed              int     `json:"completed" db:"completed"`
+	Approved               int     `json:"approved" db:"approved"`
+	Rejected               int     `json:"rejected" db:"rejected"`
+	CompletionSecondsTotal float64 `json:"completion_seconds_total" db:"completion_seconds_total"`
+}
+
+func (s TaskStats) PointsSpent() int {
+	return s.Approved * s.Cost
+}
+
+// ApprovalRate is approved / (approved + rejected), or 0 when nothing was reviewed.
+func (s TaskStats) ApprovalRate() float64 {
+	return approvalRate(s.Approved, s.Rejected)
+}
+
+func (s TaskStats) AvgTimeToCompletion() time.Duration {
+	return avgDuration(s.CompletionSecondsTotal, s.Completed)
+}
+
+type CustomerStats struct {
+	CustomerID         string
+	From               time.Time
+	To                 time.Time
+	TotalTasks         int
+	ActiveTasks        int
+	Participations     int
+	UniqueParticipants int
+	Completed          int
+	Approved           int
+	Rejected           int
+	// ApprovalRate is approved / (approved + rejected), or 0 when nothing was reviewed.
+	ApprovalRate        float64
+	AvgTimeToCompletion time.Duration
+	PointsSpent         int
+	Tasks               []*TaskStats
+}
+
+func approvalRate(approved, rejected int) float64 {
+	if reviewed := approved + rejected; reviewed > 0 {
+		return float64(approved) / float64(reviewed)
+	}
+	return 0
+}
+
+func avgDuration(totalSeconds float64, count int) time.Duration {
+	if count <= 0 {
+		return 0
+	}
+	return time.Duration(totalSeconds / float64(count) * float64(time.Second))
+}
+
+// NewCustomerStats sums taskStats into the customer-wide figures.
+func NewCustomerStats(customerID string, from, to time.Time, taskStats []*TaskStats, uniqueParticipants int) *CustomerStats {
+	stats := &CustomerStats{
+		CustomerID:         customerID,
+		From:               from,
+		To:                 to,
+		TotalTasks:         len(taskStats),
+		UniqueParticipants: uniqueParticipants,
+	}
+
+	var completionSeconds float64
+	for _, item := range taskStats {
+		if item.Active {
+			stats.ActiveTasks++
+		}
+		stats.Participations += item.Joined
+		stats.Completed += item.Completed
+		stats.Approved += item.Approved
+		stats.Rejected += item.Rejected
+		stats.PointsSpent += item.PointsSpent()
+		completionSeconds += item.CompletionSecondsTotal
+	}
+
+	stats.ApprovalRate = approvalRate(stats.Approved, stats.Rejected)
+	stats.AvgTimeToCompletion = avgDuration(completionSeconds, stats.Completed)
+
+	return stats
+}
@@ -2,9 +2,14 @@ package domain
 
 import (
 	"encoding/json"
+	"regexp"
 	"time"
 )
 
+// taskIDPattern restricts client-supplied task ids to URL- and log-safe
+// characters that fit into the tasks.id column.
+var taskIDPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:-]{0,254}$`)
+
 type Task struct {
 	ID               string           `json:"id" db:"id"`
 	CustomerID       string           `json:"customer_id" db:"customer_id"`
@@ -15,10 +20,27 @@ type Task struct {
 	MembersCount     int              `json:"members_count" db:"members_count"`
 	Meta             json.RawMessage  `json:"meta" db:"meta"`
 
+	ParticipantsPending   int `json:"participants_pending" db:"participants_pending"`
+	ParticipantsCompleted int `json:"participants_completed" db:"participants_completed"`
+	ParticipantsApproved  int `json:"participants_approved" db:"participants_approved"`
+	ParticipantsRejected  int `json:"participants_rejected" db:"participants_rejected"`
+	ParticipantsCancelled int `json:"participants_cancelled" db:"participants_cancelled"`
+
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// Closed reports whether every member slot of the task is taken by an
+// approved participant. Closed tasks are kept out of search.
+func (t *Task) Closed() bool {
+	return t.MembersCount > 0 && t.ParticipantsApproved >= t.MembersCount
+}
+
+// IsValidTaskID reports whether id can be used as an external task id.
+func IsValidTaskID(id string) bool {
+	return taskIDPattern.MatchString(id)
+}
+
 type UserTask struct {
 	UserID string `json:"user_id" db:"user_id"`
 	TaskID string `json:"task_id" db:"task_id"`
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WebhookEventType is the event name customers subscribe to and receive in
+// the webhook body.
+type WebhookEventType string
+
+const (
+	WebhookParticipantJoined    WebhookEventType = "participant.joined"
+	WebhookParticipantConfirmed WebhookEventType = "participant.confirmed"
+	WebhookParticipantApproved  WebhookEventType = "participant.approved"
+	WebhookParticipantRejected  WebhookEventType = "participant.rejected"
+	WebhookParticipantLeft      WebhookEventType = "participant.left"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookParticipantJoined,
+	WebhookParticipantConfirmed,
+	WebhookParticipantApproved,
+	WebhookParticipantRejected,
+	WebhookParticipantLeft,
+}
+
+func (t WebhookEventType) String() string {
+	return string(t)
+}
+
+func (t WebhookEventType) Valid() bool {
+	return slices.Contains(WebhookEventTypes, t)
+}
+
+// WebhookEventTypeForStatus maps a participation status change onto the
+// webhook event announcing it.
+func WebhookEventTypeForStatus(status Status) (WebhookEventType, bool) {
+	switch status {
+	case StatusInProgress:
+		return WebhookParticipantJoined, true
+	case StatusCompleted:
+		return WebhookParticipantConfirmed, true
+	case StatusApproved:
+		return WebhookParticipantApproved, true
+	case StatusRejected:
+		return WebhookParticipantRejected, true
+	case StatusCancelled:
+		return WebhookParticipantLeft, true
+	default:
+		return "", false
+	}
+}
+
+type WebhookSubscription struct {
+	ID         string    `json:"id" db:"id"`
+	CustomerID string    `json:"customer_id" db:"customer_id"`
+	URL        string    `json:"url" db:"url"`
+	Secret     string    `json:"-" db:"secret"`
+	EventTypes string    `json:"event_types" db:"event_types"`
+	Active     bool      `json:"active" db:"active"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Events returns the subscribed event types; an empty list means all of them.
+func (s *WebhookSubscription) Events() []WebhookEventType {
+	result := make([]WebhookEventType, 0)
+	for _, item := range strings.Split(s.EventTypes, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, WebhookEventType(item))
+		}
+	}
+	return result
+}
+
+func (s *WebhookSubscription) SetEvents(events []WebhookEventType) {
+	items := make([]string, 0, len(events))
+	for _, event := range events {
+		items = append(items, event.String())
+	}
+	s.EventTypes = strings.Join(items, ",")
+}
+
+func (s *WebhookSubscription) Accepts(event WebhookEventType) bool {
+	events := s.Events()
+	return len(events) == 0 || slices.Contains(events, event)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+type WebhookDelivery struct {
+	ID             int64                 `json:"id" db:"id"`
+	SubscriptionID string                `json:"subscription_id" db:"subscription_id"`
+	EventID        string                `json:"event_id" db:"event_id"`
+	EventType      WebhookEventType      `json:"event_type" db:"event_type"`
+	Payload        json.RawMessage       `json:"payload" db:"payload"`
+	Status         WebhookDeliveryStatus `json:"status" db:"status"`
+	Attempts       int                   `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" db:"next_attempt_at"`
+	LastStatusCode *int                  `json:"last_status_code" db:"last_status_code"`
+	LastError      *string               `json:"last_error" db:"last_error"`
+	DeliveredAt    *time.Time            `json:"delivered_at" db:"delivered_at"`
+	CreatedAt      time.Time             `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at" db:"updated_at"`
+
+	// URL and Secret are joined from the subscription when a delivery is
+	// picked up for sending.
+	URL    string `json:"-" db:"url"`
+	Secret string `json:"-" db:"secret"`
+}
@@ -0,0 +1,725 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: proto/events/events.proto
+
+package events
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope wraps every domain event relayed from the outbox. Delivery is
+// at-least-once, so consumers must dedupe on id. Breaking payload changes bump
+// schema_version; consumers must ignore payload cases they do not know.
+type Envelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SchemaVersion int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OccurredAt    int64                  `protobuf:"varint,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	AggregateId   string                 `protobuf:"bytes,5,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Envelope_TaskCreated
+	//	*Envelope_TaskUpdated
+	//	*Envelope_TaskDeleted
+	//	*Envelope_UserTaskCreated
+	//	*Envelope_UserTaskStatusChanged
+	Payload       isEnvelope_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_proto_events_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Envelope) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Envelope) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Envelope) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *Envelope) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *Envelope) GetPayload() isEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Envelope) GetTaskCreated() *TaskCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TaskCreated); ok {
+			return x.TaskCreated
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetTaskUpdated() *TaskUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TaskUpdated); ok {
+			return x.TaskUpdated
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetTaskDeleted() *TaskDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TaskDeleted); ok {
+			return x.TaskDeleted
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetUserTaskCreated() *UserTaskCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_UserTaskCreated); ok {
+			return x.UserTaskCreated
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetUserTaskStatusChanged() *UserTaskStatusChanged {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_UserTaskStatusChanged); ok {
+			return x.UserTaskStatusChanged
+		}
+	}
+	return nil
+}
+
+type isEnvelope_Payload interface {
+	isEnvelope_Payload()
+}
+
+type Envelope_TaskCreated struct {
+	TaskCreated *TaskCreated `protobuf:"bytes,10,opt,name=task_created,json=taskCreated,proto3,oneof"`
+}
+
+type Envelope_TaskUpdated struct {
+	TaskUpdated *TaskUpdated `protobuf:"bytes,11,opt,name=task_updated,json=taskUpdated,proto3,oneof"`
+}
+
+type Envelope_TaskDeleted struct {
+	TaskDeleted *TaskDeleted `protobuf:"bytes,12,opt,name=task_deleted,json=taskDeleted,proto3,oneof"`
+}
+
+type Envelope_UserTaskCreated struct {
+	UserTaskCreated *UserTaskCreated `protobuf:"bytes,13,opt,name=user_task_created,json=userTaskCreated,proto3,oneof"`
+}
+
+type Envelope_UserTaskStatusChanged struct {
+	UserTaskStatusChanged *UserTaskStatusChanged `protobuf:"bytes,14,opt,name=user_task_status_changed,json=userTaskStatusChanged,proto3,oneof"`
+}
+
+func (*Envelope_TaskCreated) isEnvelope_Payload() {}
+
+func (*Envelope_TaskUpdated) isEnvelope_Payload() {}
+
+func (*Envelope_TaskDeleted) isEnvelope_Payload() {}
+
+func (*Envelope_UserTaskCreated) isEnvelope_Payload() {}
+
+func (*Envelope_UserTaskStatusChanged) isEnvelope_Payload() {}
+
+type TaskSnapshot struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	VerificationType string                 `protobuf:"bytes,5,opt,name=verification_type,json=verificationType,proto3" json:"verification_type,omitempty"`
+	Cost             int32                  `protobuf:"varint,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	MembersCount     int32                  `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	Meta             map[string]string      `protobuf:"bytes,8,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt        int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskSnapshot) Reset() {
+	*x = TaskSnapshot{}
+	mi := &file_proto_events_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSnapshot) ProtoMessage() {}
+
+func (x *TaskSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSnapshot.ProtoReflect.Descriptor instead.
+func (*TaskSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskSnapshot) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *TaskSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskSnapshot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskSnapshot) GetVerificationType() string {
+	if x != nil {
+		return x.VerificationType
+	}
+	return ""
+}
+
+func (x *TaskSnapshot) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *TaskSnapshot) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TaskSnapshot) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TaskSnapshot) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TaskSnapshot) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type TaskCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskSnapshot          `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskCreated) Reset() {
+	*x = TaskCreated{}
+	mi := &file_proto_events_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCreated) ProtoMessage() {}
+
+func (x *TaskCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskCreated.ProtoReflect.Descriptor instead.
+func (*TaskCreated) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskCreated) GetTask() *TaskSnapshot {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type TaskUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *TaskSnapshot          `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskUpdated) Reset() {
+	*x = TaskUpdated{}
+	mi := &file_proto_events_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdated) ProtoMessage() {}
+
+func (x *TaskUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdated.ProtoReflect.Descriptor instead.
+func (*TaskUpdated) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskUpdated) GetTask() *TaskSnapshot {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type TaskDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDeleted) Reset() {
+	*x = TaskDeleted{}
+	mi := &file_proto_events_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDeleted) ProtoMessage() {}
+
+func (x *TaskDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDeleted.ProtoReflect.Descriptor instead.
+func (*TaskDeleted) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaskDeleted) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type UserTaskCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTaskCreated) Reset() {
+	*x = UserTaskCreated{}
+	mi := &file_proto_events_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTaskCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTaskCreated) ProtoMessage() {}
+
+func (x *UserTaskCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTaskCreated.ProtoReflect.Descriptor instead.
+func (*UserTaskCreated) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserTaskCreated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserTaskCreated) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UserTaskCreated) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UserTaskCreated) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserTaskCreated) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type UserTaskStatusChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ChangedAt     int64                  `protobuf:"varint,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTaskStatusChanged) Reset() {
+	*x = UserTaskStatusChanged{}
+	mi := &file_proto_events_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTaskStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTaskStatusChanged) ProtoMessage() {}
+
+func (x *UserTaskStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_events_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTaskStatusChanged.ProtoReflect.Descriptor instead.
+func (*UserTaskStatusChanged) Descriptor() ([]byte, []int) {
+	return file_proto_events_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserTaskStatusChanged) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserTaskStatusChanged) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UserTaskStatusChanged) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UserTaskStatusChanged) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserTaskStatusChanged) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+var File_proto_events_events_proto protoreflect.FileDescriptor
+
+const file_proto_events_events_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/events/events.proto\x12\x06events\"\xf3\x03\n" +
+	"\bEnvelope\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\x03R\n" +
+	"occurredAt\x12!\n" +
+	"\faggregate_id\x18\x05 \x01(\tR\vaggregateId\x128\n" +
+	"\ftask_created\x18\n" +
+	" \x01(\v2\x13.events.TaskCreatedH\x00R\vtaskCreated\x128\n" +
+	"\ftask_updated\x18\v \x01(\v2\x13.events.TaskUpdatedH\x00R\vtaskUpdated\x128\n" +
+	"\ftask_deleted\x18\f \x01(\v2\x13.events.TaskDeletedH\x00R\vtaskDeleted\x12E\n" +
+	"\x11user_task_created\x18\r \x01(\v2\x17.events.UserTaskCreatedH\x00R\x0fuserTaskCreated\x12X\n" +
+	"\x18user_task_status_changed\x18\x0e \x01(\v2\x1d.events.UserTaskStatusChangedH\x00R\x15userTaskStatusChangedB\t\n" +
+	"\apayload\"\x86\x03\n" +
+	"\fTaskSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12+\n" +
+	"\x11verification_type\x18\x05 \x01(\tR\x10verificationType\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\x05R\x04cost\x12#\n" +
+	"\rmembers_count\x18\a \x01(\x05R\fmembersCount\x122\n" +
+	"\x04meta\x18\b \x03(\v2\x1e.events.TaskSnapshot.MetaEntryR\x04meta\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x1a7\n" +
+	"\tMetaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\vTaskCreated\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.events.TaskSnapshotR\x04task\"7\n" +
+	"\vTaskUpdated\x12(\n" +
+	"\x04task\x18\x01 \x01(\v2\x14.events.TaskSnapshotR\x04task\"&\n" +
+	"\vTaskDeleted\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x9b\x01\n" +
+	"\x0fUserTaskCreated\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\xa1\x01\n" +
+	"\x15UserTaskStatusChanged\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\x03R\tchangedAtB9Z7DobrikaDev/task-service/internal/generated/proto/eventsb\x06proto3"
+
+var (
+	file_proto_events_events_proto_rawDescOnce sync.Once
+	file_proto_events_events_proto_rawDescData []byte
+)
+
+func file_proto_events_events_proto_rawDescGZIP() []byte {
+	file_proto_events_events_proto_rawDescOnce.Do(func() {
+		file_proto_events_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_events_events_proto_rawDesc), len(file_proto_events_events_proto_rawDesc)))
+	})
+	return file_proto_events_events_proto_rawDescData
+}
+
+var file_proto_events_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_events_events_proto_goTypes = []any{
+	(*Envelope)(nil),              // 0: events.Envelope
+	(*TaskSnapshot)(nil),          // 1: events.TaskSnapshot
+	(*TaskCreated)(nil),           // 2: events.TaskCreated
+	(*TaskUpdated)(nil),           // 3: events.TaskUpdated
+	(*TaskDeleted)(nil),           // 4: events.TaskDeleted
+	(*UserTaskCreated)(nil),       // 5: events.UserTaskCreated
+	(*UserTaskStatusChanged)(nil), // 6: events.UserTaskStatusChanged
+	nil,                           // 7: events.TaskSnapshot.MetaEntry
+}
+var file_proto_events_events_proto_depIdxs = []int32{
+	2, // 0: events.Envelope.task_created:type_name -> events.TaskCreated
+	3, // 1: events.Envelope.task_updated:type_name -> events.TaskUpdated
+	4, // 2: events.Envelope.task_deleted:type_name -> events.TaskDeleted
+	5, // 3: events.Envelope.user_task_created:type_name -> events.UserTaskCreated
+	6, // 4: events.Envelope.user_task_status_changed:type_name -> events.UserTaskStatusChanged
+	7, // 5: events.TaskSnapshot.meta:type_name -> events.TaskSnapshot.MetaEntry
+	1, // 6: events.TaskCreated.task:type_name -> events.TaskSnapshot
+	1, // 7: events.TaskUpdated.task:type_name -> events.TaskSnapshot
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_proto_events_events_proto_init() }
+func file_proto_events_events_proto_init() {
+	if File_proto_events_events_proto != nil {
+		return
+	}
+	file_proto_events_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*Envelope_TaskCreated)(nil),
+		(*Envelope_TaskUpdated)(nil),
+		(*Envelope_TaskDeleted)(nil),
+		(*Envelope_UserTaskCreated)(nil),
+		(*Envelope_UserTaskStatusChanged)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_events_events_proto_rawDesc), len(file_proto_events_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_events_events_proto_goTypes,
+		DependencyIndexes: file_proto_events_events_proto_depIdxs,
+		MessageInfos:      file_proto_events_events_proto_msgTypes,
+	}.Build()
+	File_proto_events_events_proto = out.File
+	file_proto_events_events_proto_goTypes = nil
+	file_proto_events_events_proto_depIdxs = nil
+}
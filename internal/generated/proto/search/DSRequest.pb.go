@@ -0,0 +1,558 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: DSRequest.proto
+
+package search
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserQuery     string                 `protobuf:"bytes,1,opt,name=user_query,json=userQuery,proto3" json:"user_query,omitempty"`
+	GeoData       string                 `protobuf:"bytes,2,opt,name=geo_data,json=geoData,proto3" json:"geo_data,omitempty"`
+	UserTags      []string               `protobuf:"bytes,3,rep,name=user_tags,json=userTags,proto3" json:"user_tags,omitempty"`
+	QueryType     string                 `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	SearchOffset  int32                  `protobuf:"varint,5,opt,name=search_offset,json=searchOffset,proto3" json:"search_offset,omitempty"`
+	SearchLimit   int32                  `protobuf:"varint,6,opt,name=search_limit,json=searchLimit,proto3" json:"search_limit,omitempty"`
+	Filters       *DSearchFilters        `protobuf:"bytes,7,opt,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSearchRequest) Reset() {
+	*x = DSearchRequest{}
+	mi := &file_DSRequest_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSearchRequest) ProtoMessage() {}
+
+func (x *DSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_DSRequest_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSearchRequest.ProtoReflect.Descriptor instead.
+func (*DSearchRequest) Descriptor() ([]byte, []int) {
+	return file_DSRequest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DSearchRequest) GetUserQuery() string {
+	if x != nil {
+		return x.UserQuery
+	}
+	return ""
+}
+
+func (x *DSearchRequest) GetGeoData() string {
+	if x != nil {
+		return x.GeoData
+	}
+	return ""
+}
+
+func (x *DSearchRequest) GetUserTags() []string {
+	if x != nil {
+		return x.UserTags
+	}
+	return nil
+}
+
+func (x *DSearchRequest) GetQueryType() string {
+	if x != nil {
+		return x.QueryType
+	}
+	return ""
+}
+
+func (x *DSearchRequest) GetSearchOffset() int32 {
+	if x != nil {
+		return x.SearchOffset
+	}
+	return 0
+}
+
+func (x *DSearchRequest) GetSearchLimit() int32 {
+	if x != nil {
+		return x.SearchLimit
+	}
+	return 0
+}
+
+func (x *DSearchRequest) GetFilters() *DSearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// DSearchFilters narrows a search by the structured attributes of
+// DSIndexTask. Unset fields do not filter; times are unix seconds and ranges
+// are half-open [from, to).
+type DSearchFilters struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CustomerIds       []string               `protobuf:"bytes,1,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	VerificationTypes []string               `protobuf:"bytes,2,rep,name=verification_types,json=verificationTypes,proto3" json:"verification_types,omitempty"`
+	CostMin           *int32                 `protobuf:"varint,3,opt,name=cost_min,json=costMin,proto3,oneof" json:"cost_min,omitempty"`
+	CostMax           *int32                 `protobuf:"varint,4,opt,name=cost_max,json=costMax,proto3,oneof" json:"cost_max,omitempty"`
+	// min_open_slots keeps tasks with at least this many open slots; tasks
+	// with members_count 0 have no limit and always match.
+	MinOpenSlots  int32 `protobuf:"varint,5,opt,name=min_open_slots,json=minOpenSlots,proto3" json:"min_open_slots,omitempty"`
+	CreatedFrom   int32 `protobuf:"varint,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int32 `protobuf:"varint,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom   int32 `protobuf:"varint,8,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo     int32 `protobuf:"varint,9,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSearchFilters) Reset() {
+	*x = DSearchFilters{}
+	mi := &file_DSRequest_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSearchFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSearchFilters) ProtoMessage() {}
+
+func (x *DSearchFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_DSRequest_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSearchFilters.ProtoReflect.Descriptor instead.
+func (*DSearchFilters) Descriptor() ([]byte, []int) {
+	return file_DSRequest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DSearchFilters) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+func (x *DSearchFilters) GetVerificationTypes() []string {
+	if x != nil {
+		return x.VerificationTypes
+	}
+	return nil
+}
+
+func (x *DSearchFilters) GetCostMin() int32 {
+	if x != nil && x.CostMin != nil {
+		return *x.CostMin
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetCostMax() int32 {
+	if x != nil && x.CostMax != nil {
+		return *x.CostMax
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetMinOpenSlots() int32 {
+	if x != nil {
+		return x.MinOpenSlots
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetCreatedFrom() int32 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetCreatedTo() int32 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetUpdatedFrom() int32 {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return 0
+}
+
+func (x *DSearchFilters) GetUpdatedTo() int32 {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return 0
+}
+
+type DSIndexTask struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TaskName         string                 `protobuf:"bytes,1,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	TaskDesc         string                 `protobuf:"bytes,2,opt,name=task_desc,json=taskDesc,proto3" json:"task_desc,omitempty"`
+	GeoData          string                 `protobuf:"bytes,3,opt,name=geo_data,json=geoData,proto3" json:"geo_data,omitempty"`
+	TaskId           string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType         string                 `protobuf:"bytes,5,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,6,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	VerificationType string                 `protobuf:"bytes,7,opt,name=verification_type,json=verificationType,proto3" json:"verification_type,omitempty"`
+	Cost             int32                  `protobuf:"varint,8,opt,name=cost,proto3" json:"cost,omitempty"`
+	// members_count is 0 when the task takes any number of members.
+	MembersCount int32 `protobuf:"varint,9,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	// open_slots is members_count minus the approved participants.
+	OpenSlots     int32 `protobuf:"varint,10,opt,name=open_slots,json=openSlots,proto3" json:"open_slots,omitempty"`
+	CreatedAt     int32 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int32 `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSIndexTask) Reset() {
+	*x = DSIndexTask{}
+	mi := &file_DSRequest_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSIndexTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSIndexTask) ProtoMessage() {}
+
+func (x *DSIndexTask) ProtoReflect() protoreflect.Message {
+	mi := &file_DSRequest_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSIndexTask.ProtoReflect.Descriptor instead.
+func (*DSIndexTask) Descriptor() ([]byte, []int) {
+	return file_DSRequest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DSIndexTask) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetTaskDesc() string {
+	if x != nil {
+		return x.TaskDesc
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetGeoData() string {
+	if x != nil {
+		return x.GeoData
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetVerificationType() string {
+	if x != nil {
+		return x.VerificationType
+	}
+	return ""
+}
+
+func (x *DSIndexTask) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *DSIndexTask) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *DSIndexTask) GetOpenSlots() int32 {
+	if x != nil {
+		return x.OpenSlots
+	}
+	return 0
+}
+
+func (x *DSIndexTask) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DSIndexTask) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// DSIndexBatch indexes several tasks in one call; each one succeeds or fails
+// on its own.
+type DSIndexBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*DSIndexTask         `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSIndexBatch) Reset() {
+	*x = DSIndexBatch{}
+	mi := &file_DSRequest_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSIndexBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSIndexBatch) ProtoMessage() {}
+
+func (x *DSIndexBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_DSRequest_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSIndexBatch.ProtoReflect.Descriptor instead.
+func (*DSIndexBatch) Descriptor() ([]byte, []int) {
+	return file_DSRequest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DSIndexBatch) GetTasks() []*DSIndexTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type DSDeleteTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSDeleteTask) Reset() {
+	*x = DSDeleteTask{}
+	mi := &file_DSRequest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSDeleteTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSDeleteTask) ProtoMessage() {}
+
+func (x *DSDeleteTask) ProtoReflect() protoreflect.Message {
+	mi := &file_DSRequest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSDeleteTask.ProtoReflect.Descriptor instead.
+func (*DSDeleteTask) Descriptor() ([]byte, []int) {
+	return file_DSRequest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DSDeleteTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+var File_DSRequest_proto protoreflect.FileDescriptor
+
+const file_DSRequest_proto_rawDesc = "" +
+	"\n" +
+	"\x0fDSRequest.proto\x12\x06search\"\x80\x02\n" +
+	"\x0eDSearchRequest\x12\x1d\n" +
+	"\n" +
+	"user_query\x18\x01 \x01(\tR\tuserQuery\x12\x19\n" +
+	"\bgeo_data\x18\x02 \x01(\tR\ageoData\x12\x1b\n" +
+	"\tuser_tags\x18\x03 \x03(\tR\buserTags\x12\x1d\n" +
+	"\n" +
+	"query_type\x18\x04 \x01(\tR\tqueryType\x12#\n" +
+	"\rsearch_offset\x18\x05 \x01(\x05R\fsearchOffset\x12!\n" +
+	"\fsearch_limit\x18\x06 \x01(\x05R\vsearchLimit\x120\n" +
+	"\afilters\x18\a \x01(\v2\x16.search.DSearchFiltersR\afilters\"\xe6\x02\n" +
+	"\x0eDSearchFilters\x12!\n" +
+	"\fcustomer_ids\x18\x01 \x03(\tR\vcustomerIds\x12-\n" +
+	"\x12verification_types\x18\x02 \x03(\tR\x11verificationTypes\x12\x1e\n" +
+	"\bcost_min\x18\x03 \x01(\x05H\x00R\acostMin\x88\x01\x01\x12\x1e\n" +
+	"\bcost_max\x18\x04 \x01(\x05H\x01R\acostMax\x88\x01\x01\x12$\n" +
+	"\x0emin_open_slots\x18\x05 \x01(\x05R\fminOpenSlots\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\x05R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\x05R\tcreatedTo\x12!\n" +
+	"\fupdated_from\x18\b \x01(\x05R\vupdatedFrom\x12\x1d\n" +
+	"\n" +
+	"updated_to\x18\t \x01(\x05R\tupdatedToB\v\n" +
+	"\t_cost_minB\v\n" +
+	"\t_cost_max\"\xfc\x02\n" +
+	"\vDSIndexTask\x12\x1b\n" +
+	"\ttask_name\x18\x01 \x01(\tR\btaskName\x12\x1b\n" +
+	"\ttask_desc\x18\x02 \x01(\tR\btaskDesc\x12\x19\n" +
+	"\bgeo_data\x18\x03 \x01(\tR\ageoData\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\ttask_type\x18\x05 \x01(\tR\btaskType\x12\x1f\n" +
+	"\vcustomer_id\x18\x06 \x01(\tR\n" +
+	"customerId\x12+\n" +
+	"\x11verification_type\x18\a \x01(\tR\x10verificationType\x12\x12\n" +
+	"\x04cost\x18\b \x01(\x05R\x04cost\x12#\n" +
+	"\rmembers_count\x18\t \x01(\x05R\fmembersCount\x12\x1d\n" +
+	"\n" +
+	"open_slots\x18\n" +
+	" \x01(\x05R\topenSlots\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x05R\tupdatedAt\"9\n" +
+	"\fDSIndexBatch\x12)\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x13.search.DSIndexTaskR\x05tasks\"'\n" +
+	"\fDSDeleteTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskIdB9Z7DobrikaDev/task-service/internal/generated/proto/searchb\x06proto3"
+
+var (
+	file_DSRequest_proto_rawDescOnce sync.Once
+	file_DSRequest_proto_rawDescData []byte
+)
+
+func file_DSRequest_proto_rawDescGZIP() []byte {
+	file_DSRequest_proto_rawDescOnce.Do(func() {
+		file_DSRequest_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_DSRequest_proto_rawDesc), len(file_DSRequest_proto_rawDesc)))
+	})
+	return file_DSRequest_proto_rawDescData
+}
+
+var file_DSRequest_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_DSRequest_proto_goTypes = []any{
+	(*DSearchRequest)(nil), // 0: search.DSearchRequest
+	(*DSearchFilters)(nil), // 1: search.DSearchFilters
+	(*DSIndexTask)(nil),    // 2: search.DSIndexTask
+	(*DSIndexBatch)(nil),   // 3: search.DSIndexBatch
+	(*DSDeleteTask)(nil),   // 4: search.DSDeleteTask
+}
+var file_DSRequest_proto_depIdxs = []int32{
+	1, // 0: search.DSearchRequest.filters:type_name -> search.DSearchFilters
+	2, // 1: search.DSIndexBatch.tasks:type_name -> search.DSIndexTask
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_DSRequest_proto_init() }
+func file_DSRequest_proto_init() {
+	if File_DSRequest_proto != nil {
+		return
+	}
+	file_DSRequest_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_DSRequest_proto_rawDesc), len(file_DSRequest_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_DSRequest_proto_goTypes,
+		DependencyIndexes: file_DSRequest_proto_depIdxs,
+		MessageInfos:      file_DSRequest_proto_msgTypes,
+	}.Build()
+	File_DSRequest_proto = out.File
+	file_DSRequest_proto_goTypes = nil
+	file_DSRequest_proto_depIdxs = nil
+}
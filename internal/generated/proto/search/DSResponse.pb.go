@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: DSResponse.proto
+
+package search
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DSearchResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId []string               `protobuf:"bytes,1,rep,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Total  int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// applied_filters names the DSearchFilters the server applied:
+	// customer_id, verification_type, cost, open_slots, created_at and
+	// updated_at. The caller applies the others itself.
+	AppliedFilters []string `protobuf:"bytes,4,rep,name=applied_filters,json=appliedFilters,proto3" json:"applied_filters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DSearchResult) Reset() {
+	*x = DSearchResult{}
+	mi := &file_DSResponse_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSearchResult) ProtoMessage() {}
+
+func (x *DSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_DSResponse_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSearchResult.ProtoReflect.Descriptor instead.
+func (*DSearchResult) Descriptor() ([]byte, []int) {
+	return file_DSResponse_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DSearchResult) GetTaskId() []string {
+	if x != nil {
+		return x.TaskId
+	}
+	return nil
+}
+
+func (x *DSearchResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DSearchResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DSearchResult) GetAppliedFilters() []string {
+	if x != nil {
+		return x.AppliedFilters
+	}
+	return nil
+}
+
+type DSIndexResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSIndexResult) Reset() {
+	*x = DSIndexResult{}
+	mi := &file_DSResponse_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSIndexResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSIndexResult) ProtoMessage() {}
+
+func (x *DSIndexResult) ProtoReflect() protoreflect.Message {
+	mi := &file_DSResponse_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSIndexResult.ProtoReflect.Descriptor instead.
+func (*DSIndexResult) Descriptor() ([]byte, []int) {
+	return file_DSResponse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DSIndexResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DSIndexItemResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// error is empty when the task was indexed.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSIndexItemResult) Reset() {
+	*x = DSIndexItemResult{}
+	mi := &file_DSResponse_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSIndexItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSIndexItemResult) ProtoMessage() {}
+
+func (x *DSIndexItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_DSResponse_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSIndexItemResult.ProtoReflect.Descriptor instead.
+func (*DSIndexItemResult) Descriptor() ([]byte, []int) {
+	return file_DSResponse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DSIndexItemResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DSIndexItemResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DSIndexItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DSIndexBatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DSIndexItemResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DSIndexBatchResult) Reset() {
+	*x = DSIndexBatchResult{}
+	mi := &file_DSResponse_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DSIndexBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DSIndexBatchResult) ProtoMessage() {}
+
+func (x *DSIndexBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_DSResponse_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DSIndexBatchResult.ProtoReflect.Descriptor instead.
+func (*DSIndexBatchResult) Descriptor() ([]byte, []int) {
+	return file_DSResponse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DSIndexBatchResult) GetResults() []*DSIndexItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_DSResponse_proto protoreflect.FileDescriptor
+
+const file_DSResponse_proto_rawDesc = "" +
+	"\n" +
+	"\x10DSResponse.proto\x12\x06search\"\x7f\n" +
+	"\rDSearchResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x03(\tR\x06taskId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12'\n" +
+	"\x0fapplied_filters\x18\x04 \x03(\tR\x0eappliedFilters\"'\n" +
+	"\rDSIndexResult\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Z\n" +
+	"\x11DSIndexItemResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"I\n" +
+	"\x12DSIndexBatchResult\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.search.DSIndexItemResultR\aresultsB9Z7DobrikaDev/task-service/internal/generated/proto/searchb\x06proto3"
+
+var (
+	file_DSResponse_proto_rawDescOnce sync.Once
+	file_DSResponse_proto_rawDescData []byte
+)
+
+func file_DSResponse_proto_rawDescGZIP() []byte {
+	file_DSResponse_proto_rawDescOnce.Do(func() {
+		file_DSResponse_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_DSResponse_proto_rawDesc), len(file_DSResponse_proto_rawDesc)))
+	})
+	return file_DSResponse_proto_rawDescData
+}
+
+var file_DSResponse_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_DSResponse_proto_goTypes = []any{
+	(*DSearchResult)(nil),      // 0: search.DSearchResult
+	(*DSIndexResult)(nil),      // 1: search.DSIndexResult
+	(*DSIndexItemResult)(nil),  // 2: search.DSIndexItemResult
+	(*DSIndexBatchResult)(nil), // 3: search.DSIndexBatchResult
+}
+var file_DSResponse_proto_depIdxs = []int32{
+	2, // 0: search.DSIndexBatchResult.results:type_name -> search.DSIndexItemResult
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_DSResponse_proto_init() }
+func file_DSResponse_proto_init() {
+	if File_DSResponse_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_DSResponse_proto_rawDesc), len(file_DSResponse_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_DSResponse_proto_goTypes,
+		DependencyIndexes: file_DSResponse_proto_depIdxs,
+		MessageInfos:      file_DSResponse_proto_msgTypes,
+	}.Build()
+	File_DSResponse_proto = out.File
+	file_DSResponse_proto_goTypes = nil
+	file_DSResponse_proto_depIdxs = nil
+}
@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: DServer.proto
+
+package search
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DbFileName         string                 `protobuf:"bytes,1,opt,name=db_file_name,json=dbFileName,proto3" json:"db_file_name,omitempty"`
+	ColdBackupTimerMin int32                  `protobuf:"varint,2,opt,name=cold_backup_timer_min,json=coldBackupTimerMin,proto3" json:"cold_backup_timer_min,omitempty"`
+	HotBackupTimerMin  int32                  `protobuf:"varint,3,opt,name=hot_backup_timer_min,json=hotBackupTimerMin,proto3" json:"hot_backup_timer_min,omitempty"`
+	SearchOffset       int32                  `protobuf:"varint,4,opt,name=search_offset,json=searchOffset,proto3" json:"search_offset,omitempty"`
+	SearchLimit        int32                  `protobuf:"varint,5,opt,name=search_limit,json=searchLimit,proto3" json:"search_limit,omitempty"`
+	SearchGeoIndex     int32                  `protobuf:"varint,6,opt,name=search_geo_index,json=searchGeoIndex,proto3" json:"search_geo_index,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchConfig) Reset() {
+	*x = SearchConfig{}
+	mi := &file_DServer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchConfig) ProtoMessage() {}
+
+func (x *SearchConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_DServer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchConfig.ProtoReflect.Descriptor instead.
+func (*SearchConfig) Descriptor() ([]byte, []int) {
+	return file_DServer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchConfig) GetDbFileName() string {
+	if x != nil {
+		return x.DbFileName
+	}
+	return ""
+}
+
+func (x *SearchConfig) GetColdBackupTimerMin() int32 {
+	if x != nil {
+		return x.ColdBackupTimerMin
+	}
+	return 0
+}
+
+func (x *SearchConfig) GetHotBackupTimerMin() int32 {
+	if x != nil {
+		return x.HotBackupTimerMin
+	}
+	return 0
+}
+
+func (x *SearchConfig) GetSearchOffset() int32 {
+	if x != nil {
+		return x.SearchOffset
+	}
+	return 0
+}
+
+func (x *SearchConfig) GetSearchLimit() int32 {
+	if x != nil {
+		return x.SearchLimit
+	}
+	return 0
+}
+
+func (x *SearchConfig) GetSearchGeoIndex() int32 {
+	if x != nil {
+		return x.SearchGeoIndex
+	}
+	return 0
+}
+
+type DobrikaServerConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sc            *SearchConfig          `protobuf:"bytes,1,opt,name=sc,proto3" json:"sc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DobrikaServerConfig) Reset() {
+	*x = DobrikaServerConfig{}
+	mi := &file_DServer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DobrikaServerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DobrikaServerConfig) ProtoMessage() {}
+
+func (x *DobrikaServerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_DServer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DobrikaServerConfig.ProtoReflect.Descriptor instead.
+func (*DobrikaServerConfig) Descriptor() ([]byte, []int) {
+	return file_DServer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DobrikaServerConfig) GetSc() *SearchConfig {
+	if x != nil {
+		return x.Sc
+	}
+	return nil
+}
+
+var File_DServer_proto protoreflect.FileDescriptor
+
+const file_DServer_proto_rawDesc = "" +
+	"\n" +
+	"\rDServer.proto\x12\x06search\"\x86\x02\n" +
+	"\fSearchConfig\x12 \n" +
+	"\fdb_file_name\x18\x01 \x01(\tR\n" +
+	"dbFileName\x121\n" +
+	"\x15cold_backup_timer_min\x18\x02 \x01(\x05R\x12coldBackupTimerMin\x12/\n" +
+	"\x14hot_backup_timer_min\x18\x03 \x01(\x05R\x11hotBackupTimerMin\x12#\n" +
+	"\rsearch_offset\x18\x04 \x01(\x05R\fsearchOffset\x12!\n" +
+	"\fsearch_limit\x18\x05 \x01(\x05R\vsearchLimit\x12(\n" +
+	"\x10search_geo_index\x18\x06 \x01(\x05R\x0esearchGeoIndex\";\n" +
+	"\x13DobrikaServerConfig\x12$\n" +
+	"\x02sc\x18\x01 \x01(\v2\x14.search.SearchConfigR\x02scB9Z7DobrikaDev/task-service/internal/generated/proto/searchb\x06proto3"
+
+var (
+	file_DServer_proto_rawDescOnce sync.Once
+	file_DServer_proto_rawDescData []byte
+)
+
+func file_DServer_proto_rawDescGZIP() []byte {
+	file_DServer_proto_rawDescOnce.Do(func() {
+		file_DServer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_DServer_proto_rawDesc), len(file_DServer_proto_rawDesc)))
+	})
+	return file_DServer_proto_rawDescData
+}
+
+var file_DServer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_DServer_proto_goTypes = []any{
+	(*SearchConfig)(nil),        // 0: search.SearchConfig
+	(*DobrikaServerConfig)(nil), // 1: search.DobrikaServerConfig
+}
+var file_DServer_proto_depIdxs = []int32{
+	0, // 0: search.DobrikaServerConfig.sc:type_name -> search.SearchConfig
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_DServer_proto_init() }
+func file_DServer_proto_init() {
+	if File_DServer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_DServer_proto_rawDesc), len(file_DServer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_DServer_proto_goTypes,
+		DependencyIndexes: file_DServer_proto_depIdxs,
+		MessageInfos:      file_DServer_proto_msgTypes,
+	}.Build()
+	File_DServer_proto = out.File
+	file_DServer_proto_goTypes = nil
+	file_DServer_proto_depIdxs = nil
+}
@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: DService.proto
+
+package search
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_DService_proto protoreflect.FileDescriptor
+
+const file_DService_proto_rawDesc = "" +
+	"\n" +
+	"\x0eDService.proto\x12\x06search\x1a\x0fDSRequest.proto\x1a\x10DSResponse.proto2\xf4\x01\n" +
+	"\rDobrikaSearch\x123\n" +
+	"\x05Index\x12\x13.search.DSIndexTask\x1a\x15.search.DSIndexResult\x12>\n" +
+	"\n" +
+	"IndexBatch\x12\x14.search.DSIndexBatch\x1a\x1a.search.DSIndexBatchResult\x125\n" +
+	"\x06Delete\x12\x14.search.DSDeleteTask\x1a\x15.search.DSIndexResult\x127\n" +
+	"\x06Search\x12\x16.search.DSearchRequest\x1a\x15.search.DSearchResultB9Z7DobrikaDev/task-service/internal/generated/proto/searchb\x06proto3"
+
+var file_DService_proto_goTypes = []any{
+	(*DSIndexTask)(nil),        // 0: search.DSIndexTask
+	(*DSIndexBatch)(nil),       // 1: search.DSIndexBatch
+	(*DSDeleteTask)(nil),       // 2: search.DSDeleteTask
+	(*DSearchRequest)(nil),     // 3: search.DSearchRequest
+	(*DSIndexResult)(nil),      // 4: search.DSIndexResult
+	(*DSIndexBatchResult)(nil), // 5: search.DSIndexBatchResult
+	(*DSearchResult)(nil),      // 6: search.DSearchResult
+}
+var file_DService_proto_depIdxs = []int32{
+	0, // 0: search.DobrikaSearch.Index:input_type -> search.DSIndexTask
+	1, // 1: search.DobrikaSearch.IndexBatch:input_type -> search.DSIndexBatch
+	2, // 2: search.DobrikaSearch.Delete:input_type -> search.DSDeleteTask
+	3, // 3: search.DobrikaSearch.Search:input_type -> search.DSearchRequest
+	4, // 4: search.DobrikaSearch.Index:output_type -> search.DSIndexResult
+	5, // 5: search.DobrikaSearch.IndexBatch:output_type -> search.DSIndexBatchResult
+	4, // 6: search.DobrikaSearch.Delete:output_type -> search.DSIndexResult
+	6, // 7: search.DobrikaSearch.Search:output_type -> search.DSearchResult
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_DService_proto_init() }
+func file_DService_proto_init() {
+	if File_DService_proto != nil {
+		return
+	}
+	file_DSRequest_proto_init()
+	file_DSResponse_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_DService_proto_rawDesc), len(file_DService_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_DService_proto_goTypes,
+		DependencyIndexes: file_DService_proto_depIdxs,
+	}.Build()
+	File_DService_proto = out.File
+	file_DService_proto_goTypes = nil
+	file_DService_proto_depIdxs = nil
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: DService.proto
+
+package search
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DobrikaSearch_Index_FullMethodName      = "/search.DobrikaSearch/Index"
+	DobrikaSearch_IndexBatch_FullMethodName = "/search.DobrikaSearch/IndexBatch"
+	DobrikaSearch_Delete_FullMethodName     = "/search.DobrikaSearch/Delete"
+	DobrikaSearch_Search_FullMethodName     = "/search.DobrikaSearch/Search"
+)
+
+// DobrikaSearchClient is the client API for DobrikaSearch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DobrikaSearch is the gRPC face of the search server. It mirrors the
+// /index, /index/batch, /delete and /search HTTP endpoints.
+type DobrikaSearchClient interface {
+	Index(ctx context.Context, in *DSIndexTask, opts ...grpc.CallOption) (*DSIndexResult, error)
+	IndexBatch(ctx context.Context, in *DSIndexBatch, opts ...grpc.CallOption) (*DSIndexBatchResult, error)
+	Delete(ctx context.Context, in *DSDeleteTask, opts ...grpc.CallOption) (*DSIndexResult, error)
+	Search(ctx context.Context, in *DSearchRequest, opts ...grpc.CallOption) (*DSearchResult, error)
+}
+
+type dobrikaSearchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDobrikaSearchClient(cc grpc.ClientConnInterface) DobrikaSearchClient {
+	return &dobrikaSearchClient{cc}
+}
+
+func (c *dobrikaSearchClient) Index(ctx context.Context, in *DSIndexTask, opts ...grpc.CallOption) (*DSIndexResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DSIndexResult)
+	err := c.cc.Invoke(ctx, DobrikaSearch_Index_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dobrikaSearchClient) IndexBatch(ctx context.Context, in *DSIndexBatch, opts ...grpc.CallOption) (*DSIndexBatchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DSIndexBatchResult)
+	err := c.cc.Invoke(ctx, DobrikaSearch_IndexBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dobrikaSearchClient) Delete(ctx context.Context, in *DSDeleteTask, opts ...grpc.CallOption) (*DSIndexResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DSIndexResult)
+	err := c.cc.Invoke(ctx, DobrikaSearch_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dobrikaSearchClient) Search(ctx context.Context, in *DSearchRequest, opts ...grpc.CallOption) (*DSearchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DSearchResult)
+	err := c.cc.Invoke(ctx, DobrikaSearch_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DobrikaSearchServer is the server API for DobrikaSearch service.
+// All implementations must embed UnimplementedDobrikaSearchServer
+// for forward compatibility.
+//
+// DobrikaSearch is the gRPC face of the search server. It mirrors the
+// /index, /index/batch, /delete and /search HTTP endpoints.
+type DobrikaSearchServer interface {
+	Index(context.Context, *DSIndexTask) (*DSIndexResult, error)
+	IndexBatch(context.Context, *DSIndexBatch) (*DSIndexBatchResult, error)
+	Delete(context.Context, *DSDeleteTask) (*DSIndexResult, error)
+	Search(context.Context, *DSearchRequest) (*DSearchResult, error)
+	mustEmbedUnimplementedDobrikaSearchServer()
+}
+
+// UnimplementedDobrikaSearchServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDobrikaSearchServer struct{}
+
+func (UnimplementedDobrikaSearchServer) Index(context.Context, *DSIndexTask) (*DSIndexResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Index not implemented")
+}
+func (UnimplementedDobrikaSearchServer) IndexBatch(context.Context, *DSIndexBatch) (*DSIndexBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexBatch not implemented")
+}
+func (UnimplementedDobrikaSearchServer) Delete(context.Context, *DSDeleteTask) (*DSIndexResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedDobrikaSearchServer) Search(context.Context, *DSearchRequest) (*DSearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedDobrikaSearchServer) mustEmbedUnimplementedDobrikaSearchServer() {}
+func (UnimplementedDobrikaSearchServer) testEmbeddedByValue()                       {}
+
+// UnsafeDobrikaSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DobrikaSearchServer will
+// result in compilation errors.
+type UnsafeDobrikaSearchServer interface {
+	mustEmbedUnimplementedDobrikaSearchServer()
+}
+
+func RegisterDobrikaSearchServer(s grpc.ServiceRegistrar, srv DobrikaSearchServer) {
+	// If the following call pancis, it indicates UnimplementedDobrikaSearchServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DobrikaSearch_ServiceDesc, srv)
+}
+
+func _DobrikaSearch_Index_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DSIndexTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DobrikaSearchServer).Index(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DobrikaSearch_Index_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DobrikaSearchServer).Index(ctx, req.(*DSIndexTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DobrikaSearch_IndexBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DSIndexBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DobrikaSearchServer).IndexBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DobrikaSearch_IndexBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DobrikaSearchServer).IndexBatch(ctx, req.(*DSIndexBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DobrikaSearch_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DSDeleteTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DobrikaSearchServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DobrikaSearch_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DobrikaSearchServer).Delete(ctx, req.(*DSDeleteTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DobrikaSearch_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DobrikaSearchServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DobrikaSearch_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DobrikaSearchServer).Search(ctx, req.(*DSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DobrikaSearch_ServiceDesc is the grpc.ServiceDesc for DobrikaSearch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DobrikaSearch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "search.DobrikaSearch",
+	HandlerType: (*DobrikaSearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Index",
+			Handler:    _DobrikaSearch_Index_Handler,
+		},
+		{
+			MethodName: "IndexBatch",
+			Handler:    _DobrikaSearch_IndexBatch_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DobrikaSearch_Delete_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _DobrikaSearch_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "DService.proto",
+}
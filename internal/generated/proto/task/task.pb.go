@@ -21,6 +21,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UserTaskStatus int32
+
+const (
+	UserTaskStatus_USER_TASK_STATUS_UNSPECIFIED UserTaskStatus = 0
+	UserTaskStatus_USER_TASK_STATUS_PENDING     UserTaskStatus = 1
+	UserTaskStatus_USER_TASK_STATUS_COMPLETED   UserTaskStatus = 2
+	UserTaskStatus_USER_TASK_STATUS_APPROVED    UserTaskStatus = 3
+	UserTaskStatus_USER_TASK_STATUS_REJECTED    UserTaskStatus = 4
+	UserTaskStatus_USER_TASK_STATUS_CANCELLED   UserTaskStatus = 5
+)
+
+// Enum value maps for UserTaskStatus.
+var (
+	UserTaskStatus_name = map[int32]string{
+		0: "USER_TASK_STATUS_UNSPECIFIED",
+		1: "USER_TASK_STATUS_PENDING",
+		2: "USER_TASK_STATUS_COMPLETED",
+		3: "USER_TASK_STATUS_APPROVED",
+		4: "USER_TASK_STATUS_REJECTED",
+		5: "USER_TASK_STATUS_CANCELLED",
+	}
+	UserTaskStatus_value = map[string]int32{
+		"USER_TASK_STATUS_UNSPECIFIED": 0,
+		"USER_TASK_STATUS_PENDING":     1,
+		"USER_TASK_STATUS_COMPLETED":   2,
+		"USER_TASK_STATUS_APPROVED":    3,
+		"USER_TASK_STATUS_REJECTED":    4,
+		"USER_TASK_STATUS_CANCELLED":   5,
+	}
+)
+
+func (x UserTaskStatus) Enum() *UserTaskStatus {
+	p := new(UserTaskStatus)
+	*p = x
+	return p
+}
+
+func (x UserTaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserTaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[0].Descriptor()
+}
+
+func (UserTaskStatus) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[0]
+}
+
+func (x UserTaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserTaskStatus.Descriptor instead.
+func (UserTaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED       WatchEventType = 0
+	WatchEventType_WATCH_EVENT_TYPE_TASK_CREATED      WatchEventType = 1
+	WatchEventType_WATCH_EVENT_TYPE_TASK_UPDATED      WatchEventType = 2
+	WatchEventType_WATCH_EVENT_TYPE_TASK_DELETED      WatchEventType = 3
+	WatchEventType_WATCH_EVENT_TYPE_USER_TASK_CREATED WatchEventType = 4
+	WatchEventType_WATCH_EVENT_TYPE_USER_TASK_UPDATED WatchEventType = 5
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_TYPE_UNSPECIFIED",
+		1: "WATCH_EVENT_TYPE_TASK_CREATED",
+		2: "WATCH_EVENT_TYPE_TASK_UPDATED",
+		3: "WATCH_EVENT_TYPE_TASK_DELETED",
+		4: "WATCH_EVENT_TYPE_USER_TASK_CREATED",
+		5: "WATCH_EVENT_TYPE_USER_TASK_UPDATED",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_TYPE_UNSPECIFIED":       0,
+		"WATCH_EVENT_TYPE_TASK_CREATED":      1,
+		"WATCH_EVENT_TYPE_TASK_UPDATED":      2,
+		"WATCH_EVENT_TYPE_TASK_DELETED":      3,
+		"WATCH_EVENT_TYPE_USER_TASK_CREATED": 4,
+		"WATCH_EVENT_TYPE_USER_TASK_UPDATED": 5,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[1]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{1}
+}
+
 type VerificationType int32
 
 const (
@@ -57,11 +173,11 @@ func (x VerificationType) String() string {
 }
 
 func (VerificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[0].Descriptor()
+	return file_task_proto_enumTypes[2].Descriptor()
 }
 
 func (VerificationType) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[0]
+	return &file_task_proto_enumTypes[2]
 }
 
 func (x VerificationType) Number() protoreflect.EnumNumber {
@@ -70,7 +186,377 @@ func (x VerificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VerificationType.Descriptor instead.
 func (VerificationType) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{0}
+	return file_task_proto_rawDescGZIP(), []int{2}
+}
+
+type TaskSortField int32
+
+const (
+	TaskSortField_TASK_SORT_FIELD_UNSPECIFIED TaskSortField = 0
+	TaskSortField_TASK_SORT_FIELD_CREATED_AT  TaskSortField = 1
+	TaskSortField_TASK_SORT_FIELD_UPDATED_AT  TaskSortField = 2
+	TaskSortField_TASK_SORT_FIELD_COST        TaskSortField = 3
+	TaskSortField_TASK_SORT_FIELD_NAME        TaskSortField = 4
+)
+
+// Enum value maps for TaskSortField.
+var (
+	TaskSortField_name = map[int32]string{
+		0: "TASK_SORT_FIELD_UNSPECIFIED",
+		1: "TASK_SORT_FIELD_CREATED_AT",
+		2: "TASK_SORT_FIELD_UPDATED_AT",
+		3: "TASK_SORT_FIELD_COST",
+		4: "TASK_SORT_FIELD_NAME",
+	}
+	TaskSortField_value = map[string]int32{
+		"TASK_SORT_FIELD_UNSPECIFIED": 0,
+		"TASK_SORT_FIELD_CREATED_AT":  1,
+		"TASK_SORT_FIELD_UPDATED_AT":  2,
+		"TASK_SORT_FIELD_COST":        3,
+		"TASK_SORT_FIELD_NAME":        4,
+	}
+)
+
+func (x TaskSortField) Enum() *TaskSortField {
+	p := new(TaskSortField)
+	*p = x
+	return p
+}
+
+func (x TaskSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskSortField) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[3]
+}
+
+func (x TaskSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSortField.Descriptor instead.
+func (TaskSortField) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{3}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 1
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_DESC",
+		2: "SORT_DIRECTION_ASC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_DESC":        1,
+		"SORT_DIRECTION_ASC":         2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[4].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[4]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{4}
+}
+
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	BatchMode_BATCH_MODE_ATOMIC      BatchMode = 1
+	BatchMode_BATCH_MODE_PARTIAL     BatchMode = 2
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ATOMIC",
+		2: "BATCH_MODE_PARTIAL",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED": 0,
+		"BATCH_MODE_ATOMIC":      1,
+		"BATCH_MODE_PARTIAL":     2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[5].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[5]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{5}
+}
+
+// Webhook bodies are JSON: {"id", "type", "occurred_at", "data": {"task_id",
+// "customer_id", "user_id", "status"}}. Each request carries
+// X-Dobrika-Signature: t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">.
+type WebhookEventType int32
+
+const (
+	WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED           WebhookEventType = 0
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED    WebhookEventType = 1
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED WebhookEventType = 2
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED  WebhookEventType = 3
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED  WebhookEventType = 4
+	WebhookEventType_WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT      WebhookEventType = 5
+)
+
+// Enum value maps for WebhookEventType.
+var (
+	WebhookEventType_name = map[int32]string{
+		0: "WEBHOOK_EVENT_TYPE_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED",
+		2: "WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED",
+		3: "WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED",
+		4: "WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED",
+		5: "WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT",
+	}
+	WebhookEventType_value = map[string]int32{
+		"WEBHOOK_EVENT_TYPE_UNSPECIFIED":           0,
+		"WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED":    1,
+		"WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED": 2,
+		"WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED":  3,
+		"WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED":  4,
+		"WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT":      5,
+	}
+)
+
+func (x WebhookEventType) Enum() *WebhookEventType {
+	p := new(WebhookEventType)
+	*p = x
+	return p
+}
+
+func (x WebhookEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[6].Descriptor()
+}
+
+func (WebhookEventType) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[6]
+}
+
+func (x WebhookEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEventType.Descriptor instead.
+func (WebhookEventType) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{6}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED      WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[7].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[7]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{7}
+}
+
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED           NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_TASK_APPROVED         NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_TASK_REJECTED         NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_PARTICIPANT_JOINED    NotificationType = 3
+	NotificationType_NOTIFICATION_TYPE_PARTICIPANT_CONFIRMED NotificationType = 4
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_TASK_APPROVED",
+		2: "NOTIFICATION_TYPE_TASK_REJECTED",
+		3: "NOTIFICATION_TYPE_PARTICIPANT_JOINED",
+		4: "NOTIFICATION_TYPE_PARTICIPANT_CONFIRMED",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED":           0,
+		"NOTIFICATION_TYPE_TASK_APPROVED":         1,
+		"NOTIFICATION_TYPE_TASK_REJECTED":         2,
+		"NOTIFICATION_TYPE_PARTICIPANT_JOINED":    3,
+		"NOTIFICATION_TYPE_PARTICIPANT_CONFIRMED": 4,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[8].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[8]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{8}
+}
+
+type NotificationChannel int32
+
+const (
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	NotificationChannel_NOTIFICATION_CHANNEL_PUSH        NotificationChannel = 1
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL       NotificationChannel = 2
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_PUSH",
+		2: "NOTIFICATION_CHANNEL_EMAIL",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_PUSH":        1,
+		"NOTIFICATION_CHANNEL_EMAIL":       2,
+	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
+}
+
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_task_proto_enumTypes[9].Descriptor()
+}
+
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_task_proto_enumTypes[9]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{9}
 }
 
 type ErrorCode int32
@@ -81,6 +567,7 @@ const (
 	ErrorCode_ERROR_CODE_NOT_FOUND      ErrorCode = 2
 	ErrorCode_ERROR_CODE_INTERNAL       ErrorCode = 3
 	ErrorCode_ERROR_CODE_ALREADY_EXISTS ErrorCode = 4
+	ErrorCode_ERROR_CODE_EXPIRED        ErrorCode = 5
 )
 
 // Enum value maps for ErrorCode.
@@ -91,6 +578,7 @@ var (
 		2: "ERROR_CODE_NOT_FOUND",
 		3: "ERROR_CODE_INTERNAL",
 		4: "ERROR_CODE_ALREADY_EXISTS",
+		5: "ERROR_CODE_EXPIRED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":    0,
@@ -98,6 +586,7 @@ var (
 		"ERROR_CODE_NOT_FOUND":      2,
 		"ERROR_CODE_INTERNAL":       3,
 		"ERROR_CODE_ALREADY_EXISTS": 4,
+		"ERROR_CODE_EXPIRED":        5,
 	}
 )
 
@@ -112,11 +601,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_task_proto_enumTypes[1].Descriptor()
+	return file_task_proto_enumTypes[10].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_task_proto_enumTypes[1]
+	return &file_task_proto_enumTypes[10]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -125,13 +614,15 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{1}
+	return file_task_proto_rawDescGZIP(), []int{10}
 }
 
 type UserJoinTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// search_id is the SearchTasksResponse.search_id the task was found with, if any.
+	SearchId      string `protobuf:"bytes,3,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -180,6 +671,13 @@ func (x *UserJoinTaskRequest) GetTaskId() string {
 	return ""
 }
 
+func (x *UserJoinTaskRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
 type UserJoinTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
@@ -608,36 +1106,32 @@ func (x *RejectTaskResponse) GetError() *Error {
 	return nil
 }
 
-type Task struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	VerificationType VerificationType       `protobuf:"varint,5,opt,name=verification_type,json=verificationType,proto3,enum=task.VerificationType" json:"verification_type,omitempty"`
-	Cost             int32                  `protobuf:"varint,6,opt,name=cost,proto3" json:"cost,omitempty"`
-	MembersCount     int32                  `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
-	Meta             []*Meta                `protobuf:"bytes,8,rep,name=meta,proto3" json:"meta,omitempty"`
-	CreatedAt        int32                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        int32                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UserTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status        UserTaskStatus         `protobuf:"varint,3,opt,name=status,proto3,enum=task.UserTaskStatus" json:"status,omitempty"`
+	CreatedAt     int32                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int32                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Task          *Task                  `protobuf:"bytes,6,opt,name=Task,proto3" json:"Task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *UserTask) Reset() {
+	*x = UserTask{}
 	mi := &file_task_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *UserTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*UserTask) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *UserTask) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,103 +1143,77 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserTask.ProtoReflect.Descriptor instead.
+func (*UserTask) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Task) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Task) GetCustomerId() string {
-	if x != nil {
-		return x.CustomerId
-	}
-	return ""
-}
-
-func (x *Task) GetName() string {
+func (x *UserTask) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Task) GetDescription() string {
+func (x *UserTask) GetTaskId() string {
 	if x != nil {
-		return x.Description
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *Task) GetVerificationType() VerificationType {
+func (x *UserTask) GetStatus() UserTaskStatus {
 	if x != nil {
-		return x.VerificationType
+		return x.Status
 	}
-	return VerificationType_VERIFICATION_TYPE_UNSPECIFIED
+	return UserTaskStatus_USER_TASK_STATUS_UNSPECIFIED
 }
 
-func (x *Task) GetCost() int32 {
+func (x *UserTask) GetCreatedAt() int32 {
 	if x != nil {
-		return x.Cost
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Task) GetMembersCount() int32 {
+func (x *UserTask) GetUpdatedAt() int32 {
 	if x != nil {
-		return x.MembersCount
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *Task) GetMeta() []*Meta {
+func (x *UserTask) GetTask() *Task {
 	if x != nil {
-		return x.Meta
+		return x.Task
 	}
 	return nil
 }
 
-func (x *Task) GetCreatedAt() int32 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Task) GetUpdatedAt() int32 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-type Meta struct {
+type ListUserTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Statuses      []UserTaskStatus       `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=task.UserTaskStatus" json:"statuses,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Meta) Reset() {
-	*x = Meta{}
+func (x *ListUserTasksRequest) Reset() {
+	*x = ListUserTasksRequest{}
 	mi := &file_task_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Meta) String() string {
+func (x *ListUserTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Meta) ProtoMessage() {}
+func (*ListUserTasksRequest) ProtoMessage() {}
 
-func (x *Meta) ProtoReflect() protoreflect.Message {
+func (x *ListUserTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,46 +1225,62 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
-func (*Meta) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTasksRequest) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Meta) GetKey() string {
+func (x *ListUserTasksRequest) GetUserId() string {
 	if x != nil {
-		return x.Key
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Meta) GetValue() string {
+func (x *ListUserTasksRequest) GetStatuses() []UserTaskStatus {
 	if x != nil {
-		return x.Value
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-type CreateTaskRequest struct {
+func (x *ListUserTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserTasksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListUserTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	UserTasks     []*UserTask            `protobuf:"bytes,1,rep,name=user_tasks,json=userTasks,proto3" json:"user_tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
+func (x *ListUserTasksResponse) Reset() {
+	*x = ListUserTasksResponse{}
 	mi := &file_task_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *ListUserTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*ListUserTasksResponse) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUserTasksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,41 +1292,56 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListUserTasksResponse) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateTaskRequest) GetTask() *Task {
+func (x *ListUserTasksResponse) GetUserTasks() []*UserTask {
 	if x != nil {
-		return x.Task
+		return x.UserTasks
 	}
 	return nil
 }
 
-type GetTasksRequest struct {
+func (x *ListUserTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUserTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListTaskParticipantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Statuses      []UserTaskStatus       `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=task.UserTaskStatus" json:"statuses,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksRequest) Reset() {
-	*x = GetTasksRequest{}
+func (x *ListTaskParticipantsRequest) Reset() {
+	*x = ListTaskParticipantsRequest{}
 	mi := &file_task_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksRequest) String() string {
+func (x *ListTaskParticipantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksRequest) ProtoMessage() {}
+func (*ListTaskParticipantsRequest) ProtoMessage() {}
 
-func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTaskParticipantsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -854,55 +1353,62 @@ func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTaskParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskParticipantsRequest) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTasksRequest) GetCustomerId() string {
+func (x *ListTaskParticipantsRequest) GetTaskId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *GetTasksRequest) GetLimit() int32 {
+func (x *ListTaskParticipantsRequest) GetStatuses() []UserTaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListTaskParticipantsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetTasksRequest) GetOffset() int32 {
+func (x *ListTaskParticipantsRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-type GetTasksResponse struct {
+type ListTaskParticipantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	Participants  []*UserTask            `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksResponse) Reset() {
-	*x = GetTasksResponse{}
+func (x *ListTaskParticipantsResponse) Reset() {
+	*x = ListTaskParticipantsResponse{}
 	mi := &file_task_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksResponse) String() string {
+func (x *ListTaskParticipantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksResponse) ProtoMessage() {}
+func (*ListTaskParticipantsResponse) ProtoMessage() {}
 
-func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTaskParticipantsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,56 +1420,55 @@ func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTaskParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskParticipantsResponse) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetTasksResponse) GetTasks() []*Task {
+func (x *ListTaskParticipantsResponse) GetParticipants() []*UserTask {
 	if x != nil {
-		return x.Tasks
+		return x.Participants
 	}
 	return nil
 }
 
-func (x *GetTasksResponse) GetTotal() int32 {
+func (x *ListTaskParticipantsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *GetTasksResponse) GetError() *Error {
+func (x *ListTaskParticipantsResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type SearchTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	QueryType     string                 `protobuf:"bytes,2,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
-	GeoData       string                 `protobuf:"bytes,3,opt,name=geo_data,json=geoData,proto3" json:"geo_data,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+type WatchTaskRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// after_event_id resumes the stream after the last event the client received.
+	AfterEventId  string `protobuf:"bytes,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchTasksRequest) Reset() {
-	*x = SearchTasksRequest{}
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
 	mi := &file_task_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchTasksRequest) String() string {
+func (x *WatchTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchTasksRequest) ProtoMessage() {}
+func (*WatchTaskRequest) ProtoMessage() {}
 
-func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_task_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,62 +1480,107 @@ func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
-func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
 	return file_task_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SearchTasksRequest) GetQuery() string {
+func (x *WatchTaskRequest) GetTaskId() string {
 	if x != nil {
-		return x.Query
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *SearchTasksRequest) GetQueryType() string {
+func (x *WatchTaskRequest) GetAfterEventId() string {
 	if x != nil {
-		return x.QueryType
+		return x.AfterEventId
 	}
 	return ""
 }
 
-func (x *SearchTasksRequest) GetGeoData() string {
+type WatchUserTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AfterEventId  string                 `protobuf:"bytes,2,opt,name=after_event_id,json=afterEventId,proto3" json:"after_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchUserTasksRequest) Reset() {
+	*x = WatchUserTasksRequest{}
+	mi := &file_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchUserTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUserTasksRequest) ProtoMessage() {}
+
+func (x *WatchUserTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[16]
 	if x != nil {
-		return x.GeoData
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUserTasksRequest.ProtoReflect.Descriptor instead.
+func (*WatchUserTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchUserTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SearchTasksRequest) GetTags() []string {
+func (x *WatchUserTasksRequest) GetAfterEventId() string {
 	if x != nil {
-		return x.Tags
+		return x.AfterEventId
 	}
-	return nil
+	return ""
 }
 
-type SearchTasksResponse struct {
+// WatchEvent is one change pushed to a watcher. A stream that cannot start
+// sends a single event carrying only error and then ends.
+type WatchEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type          WatchEventType         `protobuf:"varint,2,opt,name=type,proto3,enum=task.WatchEventType" json:"type,omitempty"`
+	OccurredAt    int32                  `protobuf:"varint,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	TaskId        string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,5,opt,name=Task,proto3" json:"Task,omitempty"`
+	UserTask      *UserTask              `protobuf:"bytes,6,opt,name=user_task,json=userTask,proto3" json:"user_task,omitempty"`
+	Error         *Error                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchTasksResponse) Reset() {
-	*x = SearchTasksResponse{}
-	mi := &file_task_proto_msgTypes[16]
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_task_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchTasksResponse) String() string {
+func (x *WatchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchTasksResponse) ProtoMessage() {}
+func (*WatchEvent) ProtoMessage() {}
 
-func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[16]
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,47 +1591,4105 @@ func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
-func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SearchTasksResponse) GetTasks() []*Task {
+func (x *WatchEvent) GetEventId() string {
 	if x != nil {
-		return x.Tasks
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_WATCH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchEvent) GetOccurredAt() int32 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetTask() *Task {
+	if x != nil {
+		return x.Task
 	}
 	return nil
 }
 
-func (x *SearchTasksResponse) GetError() *Error {
+func (x *WatchEvent) GetUserTask() *UserTask {
+	if x != nil {
+		return x.UserTask
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type GetTaskByIDRequest struct {
+type GetCustomerStatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// window defaults to the 30 days before now.
+	Window        *TimeRange `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	IncludeTasks  bool       `protobuf:"varint,3,opt,name=include_tasks,json=includeTasks,proto3" json:"include_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerStatsRequest) Reset() {
+	*x = GetCustomerStatsRequest{}
+	mi := &file_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerStatsRequest) ProtoMessage() {}
+
+func (x *GetCustomerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCustomerStatsRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetCustomerStatsRequest) GetWindow() *TimeRange {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *GetCustomerStatsRequest) GetIncludeTasks() bool {
+	if x != nil {
+		return x.IncludeTasks
+	}
+	return false
+}
+
+type GetCustomerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *CustomerStats         `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerStatsResponse) Reset() {
+	*x = GetCustomerStatsResponse{}
+	mi := &file_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerStatsResponse) ProtoMessage() {}
+
+func (x *GetCustomerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCustomerStatsResponse) GetStats() *CustomerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetCustomerStatsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetSearchAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// window defaults to the 30 days before now.
+	Window *TimeRange `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// limit caps top_queries and zero_result_queries; it defaults to 10 and may not exceed 100.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchAnalyticsRequest) Reset() {
+	*x = GetSearchAnalyticsRequest{}
+	mi := &file_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSearchAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSearchAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSearchAnalyticsRequest) GetWindow() *TimeRange {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSearchAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analytics     *SearchAnalytics       `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSearchAnalyticsResponse) Reset() {
+	*x = GetSearchAnalyticsResponse{}
+	mi := &file_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSearchAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSearchAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSearchAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSearchAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSearchAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSearchAnalyticsResponse) GetAnalytics() *SearchAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+func (x *GetSearchAnalyticsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SearchAnalytics covers the searches logged inside [from, to) and the joins attributed to them. Queries are normalised to lower case with single spaces.
+type SearchAnalytics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	From               int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                 int32                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Searches           int32                  `protobuf:"varint,3,opt,name=searches,proto3" json:"searches,omitempty"`
+	ZeroResultSearches int32                  `protobuf:"varint,4,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	// converted_searches led to at least one attributed join.
+	ConvertedSearches int32 `protobuf:"varint,5,opt,name=converted_searches,json=convertedSearches,proto3" json:"converted_searches,omitempty"`
+	Joins             int32 `protobuf:"varint,6,opt,name=joins,proto3" json:"joins,omitempty"`
+	// conversion_rate is converted_searches / searches.
+	ConversionRate float64 `protobuf:"fixed64,7,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	AvgLatencyMs   int64   `protobuf:"varint,8,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	// top_queries are the most searched queries.
+	TopQueries []*SearchQueryStats `protobuf:"bytes,9,rep,name=top_queries,json=topQueries,proto3" json:"top_queries,omitempty"`
+	// zero_result_queries are the queries that most often found nothing.
+	ZeroResultQueries []*SearchQueryStats `protobuf:"bytes,10,rep,name=zero_result_queries,json=zeroResultQueries,proto3" json:"zero_result_queries,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchAnalytics) Reset() {
+	*x = SearchAnalytics{}
+	mi := &file_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnalytics) ProtoMessage() {}
+
+func (x *SearchAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnalytics.ProtoReflect.Descriptor instead.
+func (*SearchAnalytics) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchAnalytics) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetSearches() int32 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetZeroResultSearches() int32 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetConvertedSearches() int32 {
+	if x != nil {
+		return x.ConvertedSearches
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetJoins() int32 {
+	if x != nil {
+		return x.Joins
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetAvgLatencyMs() int64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *SearchAnalytics) GetTopQueries() []*SearchQueryStats {
+	if x != nil {
+		return x.TopQueries
+	}
+	return nil
+}
+
+func (x *SearchAnalytics) GetZeroResultQueries() []*SearchQueryStats {
+	if x != nil {
+		return x.ZeroResultQueries
+	}
+	return nil
+}
+
+type SearchQueryStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches       int32                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	ZeroResults    int32                  `protobuf:"varint,3,opt,name=zero_results,json=zeroResults,proto3" json:"zero_results,omitempty"`
+	Converted      int32                  `protobuf:"varint,4,opt,name=converted,proto3" json:"converted,omitempty"`
+	Joins          int32                  `protobuf:"varint,5,opt,name=joins,proto3" json:"joins,omitempty"`
+	ConversionRate float64                `protobuf:"fixed64,6,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchQueryStats) Reset() {
+	*x = SearchQueryStats{}
+	mi := &file_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQueryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQueryStats) ProtoMessage() {}
+
+func (x *SearchQueryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQueryStats.ProtoReflect.Descriptor instead.
+func (*SearchQueryStats) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchQueryStats) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQueryStats) GetSearches() int32 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetZeroResults() int32 {
+	if x != nil {
+		return x.ZeroResults
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetConverted() int32 {
+	if x != nil {
+		return x.Converted
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetJoins() int32 {
+	if x != nil {
+		return x.Joins
+	}
+	return 0
+}
+
+func (x *SearchQueryStats) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+type CustomerStats struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	From       int32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         int32                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	TotalTasks int32                  `protobuf:"varint,4,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	// active_tasks were created or had participation activity inside the window.
+	ActiveTasks        int32 `protobuf:"varint,5,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	Participations     int32 `protobuf:"varint,6,opt,name=participations,proto3" json:"participations,omitempty"`
+	UniqueParticipants int32 `protobuf:"varint,7,opt,name=unique_participants,json=uniqueParticipants,proto3" json:"unique_participants,omitempty"`
+	Completed          int32 `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	Approved           int32 `protobuf:"varint,9,opt,name=approved,proto3" json:"approved,omitempty"`
+	Rejected           int32 `protobuf:"varint,10,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// approval_rate is approved / (approved + rejected).
+	ApprovalRate               float64      `protobuf:"fixed64,11,opt,name=approval_rate,json=approvalRate,proto3" json:"approval_rate,omitempty"`
+	AvgTimeToCompletionSeconds int64        `protobuf:"varint,12,opt,name=avg_time_to_completion_seconds,json=avgTimeToCompletionSeconds,proto3" json:"avg_time_to_completion_seconds,omitempty"`
+	PointsSpent                int64        `protobuf:"varint,13,opt,name=points_spent,json=pointsSpent,proto3" json:"points_spent,omitempty"`
+	Tasks                      []*TaskStats `protobuf:"bytes,14,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CustomerStats) Reset() {
+	*x = CustomerStats{}
+	mi := &file_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerStats) ProtoMessage() {}
+
+func (x *CustomerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerStats.ProtoReflect.Descriptor instead.
+func (*CustomerStats) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CustomerStats) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerStats) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetTotalTasks() int32 {
+	if x != nil {
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetActiveTasks() int32 {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetParticipations() int32 {
+	if x != nil {
+		return x.Participations
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetUniqueParticipants() int32 {
+	if x != nil {
+		return x.UniqueParticipants
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetApproved() int32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetApprovalRate() float64 {
+	if x != nil {
+		return x.ApprovalRate
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetAvgTimeToCompletionSeconds() int64 {
+	if x != nil {
+		return x.AvgTimeToCompletionSeconds
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetPointsSpent() int64 {
+	if x != nil {
+		return x.PointsSpent
+	}
+	return 0
+}
+
+func (x *CustomerStats) GetTasks() []*TaskStats {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskStats struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	TaskId                     string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Name                       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Active                     bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Joined                     int32                  `protobuf:"varint,4,opt,name=joined,proto3" json:"joined,omitempty"`
+	Completed                  int32                  `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	Approved                   int32                  `protobuf:"varint,6,opt,name=approved,proto3" json:"approved,omitempty"`
+	Rejected                   int32                  `protobuf:"varint,7,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	ApprovalRate               float64                `protobuf:"fixed64,8,opt,name=approval_rate,json=approvalRate,proto3" json:"approval_rate,omitempty"`
+	AvgTimeToCompletionSeconds int64                  `protobuf:"varint,9,opt,name=avg_time_to_completion_seconds,json=avgTimeToCompletionSeconds,proto3" json:"avg_time_to_completion_seconds,omitempty"`
+	PointsSpent                int64                  `protobuf:"varint,10,opt,name=points_spent,json=pointsSpent,proto3" json:"points_spent,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TaskStats) Reset() {
+	*x = TaskStats{}
+	mi := &file_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStats) ProtoMessage() {}
+
+func (x *TaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStats.ProtoReflect.Descriptor instead.
+func (*TaskStats) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TaskStats) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskStats) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *TaskStats) GetJoined() int32 {
+	if x != nil {
+		return x.Joined
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TaskStats) GetApproved() int32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *TaskStats) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *TaskStats) GetApprovalRate() float64 {
+	if x != nil {
+		return x.ApprovalRate
+	}
+	return 0
+}
+
+func (x *TaskStats) GetAvgTimeToCompletionSeconds() int64 {
+	if x != nil {
+		return x.AvgTimeToCompletionSeconds
+	}
+	return 0
+}
+
+func (x *TaskStats) GetPointsSpent() int64 {
+	if x != nil {
+		return x.PointsSpent
+	}
+	return 0
+}
+
+type Task struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId       string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	VerificationType VerificationType       `protobuf:"varint,5,opt,name=verification_type,json=verificationType,proto3,enum=task.VerificationType" json:"verification_type,omitempty"`
+	Cost             int32                  `protobuf:"varint,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	MembersCount     int32                  `protobuf:"varint,7,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	Meta             []*Meta                `protobuf:"bytes,8,rep,name=meta,proto3" json:"meta,omitempty"`
+	CreatedAt        int32                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        int32                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Participants     *ParticipantCounters   `protobuf:"bytes,11,opt,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetVerificationType() VerificationType {
+	if x != nil {
+		return x.VerificationType
+	}
+	return VerificationType_VERIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *Task) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Task) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *Task) GetMeta() []*Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Task) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Task) GetParticipants() *ParticipantCounters {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+// ParticipantCounters is read-only; it is ignored on create and update.
+type ParticipantCounters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pending       int32                  `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Approved      int32                  `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Rejected      int32                  `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Cancelled     int32                  `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantCounters) Reset() {
+	*x = ParticipantCounters{}
+	mi := &file_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantCounters) ProtoMessage() {}
+
+func (x *ParticipantCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantCounters.ProtoReflect.Descriptor instead.
+func (*ParticipantCounters) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ParticipantCounters) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ParticipantCounters) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ParticipantCounters) GetApproved() int32 {
+	if x != nil {
+		return x.Approved
+	}
+	return 0
+}
+
+func (x *ParticipantCounters) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ParticipantCounters) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+type Meta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	mi := &file_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Meta) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Meta) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortField     TaskSortField          `protobuf:"varint,5,opt,name=sort_field,json=sortField,proto3,enum=task.TaskSortField" json:"sort_field,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,6,opt,name=sort_direction,json=sortDirection,proto3,enum=task.SortDirection" json:"sort_direction,omitempty"`
+	// page_token continues a listing from a previous next_page_token; it cannot be combined with offset.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// skip_total omits the COUNT query; total is then left at 0.
+	SkipTotal     bool `protobuf:"varint,8,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksRequest) Reset() {
+	*x = GetTasksRequest{}
+	mi := &file_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksRequest) ProtoMessage() {}
+
+func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTasksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetSortField() TaskSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return TaskSortField_TASK_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetTasksRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+type TaskFilter struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Ids               []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	CustomerIds       []string               `protobuf:"bytes,2,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NameLike          string                 `protobuf:"bytes,4,opt,name=name_like,json=nameLike,proto3" json:"name_like,omitempty"`
+	VerificationTypes []VerificationType     `protobuf:"varint,5,rep,packed,name=verification_types,json=verificationTypes,proto3,enum=task.VerificationType" json:"verification_types,omitempty"`
+	CostMin           *int32                 `protobuf:"varint,6,opt,name=cost_min,json=costMin,proto3,oneof" json:"cost_min,omitempty"`
+	CostMax           *int32                 `protobuf:"varint,7,opt,name=cost_max,json=costMax,proto3,oneof" json:"cost_max,omitempty"`
+	Created           *TimeRange             `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	Updated           *TimeRange             `protobuf:"bytes,9,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TaskFilter) Reset() {
+	*x = TaskFilter{}
+	mi := &file_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFilter) ProtoMessage() {}
+
+func (x *TaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TaskFilter) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetNameLike() string {
+	if x != nil {
+		return x.NameLike
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetVerificationTypes() []VerificationType {
+	if x != nil {
+		return x.VerificationTypes
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetCostMin() int32 {
+	if x != nil && x.CostMin != nil {
+		return *x.CostMin
+	}
+	return 0
+}
+
+func (x *TaskFilter) GetCostMax() int32 {
+	if x != nil && x.CostMax != nil {
+		return *x.CostMax
+	}
+	return 0
+}
+
+func (x *TaskFilter) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetUpdated() *TimeRange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// TimeRange is a half-open [from, to) interval of unix seconds; 0 leaves a side open.
+type TimeRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TimeRange) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TimeRange) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type GetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksResponse) Reset() {
+	*x = GetTasksResponse{}
+	mi := &file_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksResponse) ProtoMessage() {}
+
+func (x *GetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchTasksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Query     string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	QueryType string                 `protobuf:"bytes,2,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	GeoData   string                 `protobuf:"bytes,3,opt,name=geo_data,json=geoData,proto3" json:"geo_data,omitempty"`
+	Tags      []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// limit defaults to 20 and may not exceed 100.
+	Limit  int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// page_token continues from a previous next_page_token for the same query; it cannot be combined with offset.
+	PageToken string        `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *SearchFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// user_id is the searching user; it is only used for search analytics.
+	UserId        string `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetQueryType() string {
+	if x != nil {
+		return x.QueryType
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetGeoData() string {
+	if x != nil {
+		return x.GeoData
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// SearchFilter narrows search results by task attributes. The search backend
+// applies what it supports; the rest is applied to each page, which may then
+// hold fewer than limit tasks.
+type SearchFilter struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CustomerIds       []string               `protobuf:"bytes,1,rep,name=customer_ids,json=customerIds,proto3" json:"customer_ids,omitempty"`
+	VerificationTypes []VerificationType     `protobuf:"varint,2,rep,packed,name=verification_types,json=verificationTypes,proto3,enum=task.VerificationType" json:"verification_types,omitempty"`
+	CostMin           *int32                 `protobuf:"varint,3,opt,name=cost_min,json=costMin,proto3,oneof" json:"cost_min,omitempty"`
+	CostMax           *int32                 `protobuf:"varint,4,opt,name=cost_max,json=costMax,proto3,oneof" json:"cost_max,omitempty"`
+	// min_open_slots keeps tasks with at least this many member slots not taken by approved participants; tasks without a member limit always match.
+	MinOpenSlots  int32      `protobuf:"varint,5,opt,name=min_open_slots,json=minOpenSlots,proto3" json:"min_open_slots,omitempty"`
+	Created       *TimeRange `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       *TimeRange `protobuf:"bytes,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	mi := &file_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchFilter) GetCustomerIds() []string {
+	if x != nil {
+		return x.CustomerIds
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetVerificationTypes() []VerificationType {
+	if x != nil {
+		return x.VerificationTypes
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetCostMin() int32 {
+	if x != nil && x.CostMin != nil {
+		return *x.CostMin
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetCostMax() int32 {
+	if x != nil && x.CostMax != nil {
+		return *x.CostMax
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetMinOpenSlots() int32 {
+	if x != nil {
+		return x.MinOpenSlots
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetCreated() *TimeRange {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetUpdated() *TimeRange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type SearchTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tasks []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	Error *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// total is 0 when the search backend does not report it or some filters had to be applied after the search.
+	Total         int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// search_id attributes joins to this search when passed to UserJoinTask; pages continued with next_page_token keep it. It is empty when search analytics are disabled.
+	SearchId      string `protobuf:"bytes,5,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchTasksResponse) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+type GetTaskByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskByIDRequest) Reset() {
+	*x = GetTaskByIDRequest{}
+	mi := &file_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskByIDRequest) ProtoMessage() {}
+
+func (x *GetTaskByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskByIDRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTaskByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTaskByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskByIDResponse) Reset() {
+	*x = GetTaskByIDResponse{}
+	mi := &file_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskByIDResponse) ProtoMessage() {}
+
+func (x *GetTaskByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskByIDResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTaskByIDResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *GetTaskByIDResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *UpdateTaskResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteTaskResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTaskResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CreateTaskResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchTaskResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTaskResult) Reset() {
+	*x = BatchTaskResult{}
+	mi := &file_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTaskResult) ProtoMessage() {}
+
+func (x *BatchTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTaskResult.ProtoReflect.Descriptor instead.
+func (*BatchTaskResult) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BatchTaskResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchTaskResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *BatchTaskResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchGetTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTasksRequest) Reset() {
+	*x = BatchGetTasksRequest{}
+	mi := &file_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTasksRequest) ProtoMessage() {}
+
+func (x *BatchGetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchGetTasksRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	MissingIds    []string               `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTasksResponse) Reset() {
+	*x = BatchGetTasksResponse{}
+	mi := &file_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTasksResponse) ProtoMessage() {}
+
+func (x *BatchGetTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchGetTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BatchGetTasksResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+func (x *BatchGetTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchCreateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=task.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksRequest) Reset() {
+	*x = BatchCreateTasksRequest{}
+	mi := &file_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksRequest) ProtoMessage() {}
+
+func (x *BatchCreateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchCreateTasksRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BatchCreateTasksRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type BatchCreateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchTaskResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksResponse) Reset() {
+	*x = BatchCreateTasksResponse{}
+	mi := &file_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksResponse) ProtoMessage() {}
+
+func (x *BatchCreateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchCreateTasksResponse) GetResults() []*BatchTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCreateTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type BatchUpdateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	Mode          BatchMode              `protobuf:"varint,2,opt,name=mode,proto3,enum=task.BatchMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksRequest) Reset() {
+	*x = BatchUpdateTasksRequest{}
+	mi := &file_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchUpdateTasksRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *BatchUpdateTasksRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+type BatchUpdateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchTaskResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksResponse) Reset() {
+	*x = BatchUpdateTasksResponse{}
+	mi := &file_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTasksResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchUpdateTasksResponse) GetResults() []*BatchTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Webhook struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Url        string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// secret is only returned by CreateWebhook.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// event_types is empty when the webhook receives every event.
+	EventTypes    []WebhookEventType `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.WebhookEventType" json:"event_types,omitempty"`
+	Active        bool               `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     int32              `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int32              `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Webhook) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      WebhookEventType       `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=task.WebhookEventType" json:"event_type,omitempty"`
+	Payload        string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=task.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  int32                  `protobuf:"varint,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,9,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt    int32                  `protobuf:"varint,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      int32                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() WebhookEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int32 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int32 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateWebhookRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Url        string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// secret is generated when empty.
+	Secret        string             `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.WebhookEventType" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateWebhookRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateWebhookRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Url        *string                `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// event_types replaces the filter when update_event_types is set.
+	EventTypes       []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=task.WebhookEventType" json:"event_types,omitempty"`
+	UpdateEventTypes bool               `protobuf:"varint,5,opt,name=update_event_types,json=updateEventTypes,proto3" json:"update_event_types,omitempty"`
+	Active           *bool              `protobuf:"varint,6,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetUpdateEventTypes() bool {
+	if x != nil {
+		return x.UpdateEventTypes
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateWebhookResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListWebhooksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *Error                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteWebhookResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *RedeliverWebhookRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RedeliverWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *RedeliverWebhookResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Type          NotificationType       `protobuf:"varint,3,opt,name=type,proto3,enum=task.NotificationType" json:"type,omitempty"`
+	TaskId        string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Read          bool                   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     int32                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *Notification) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListNotificationsRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Unread        int32                  `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`
+	Error         *Error                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetUnread() int32 {
+	if x != nil {
+		return x.Unread
+	}
+	return 0
+}
+
+func (x *ListNotificationsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type MarkNotificationsReadRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// ids empty marks the whole inbox as read.
+	Ids           []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MarkNotificationsReadRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int32                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MarkNotificationsReadResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *MarkNotificationsReadResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// NotificationPreferences choose the external channels; notifications always
+// land in the inbox. Quiet hours are minutes after local midnight and may wrap
+// past midnight; deliveries falling inside are postponed until they end.
+type NotificationPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channels        []NotificationChannel  `protobuf:"varint,2,rep,packed,name=channels,proto3,enum=task.NotificationChannel" json:"channels,omitempty"`
+	QuietHoursStart *int32                 `protobuf:"varint,3,opt,name=quiet_hours_start,json=quietHoursStart,proto3,oneof" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *int32                 `protobuf:"varint,4,opt,name=quiet_hours_end,json=quietHoursEnd,proto3,oneof" json:"quiet_hours_end,omitempty"`
+	Timezone        string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *NotificationPreferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetChannels() []NotificationChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetQuietHoursStart() int32 {
+	if x != nil && x.QuietHoursStart != nil {
+		return *x.QuietHoursStart
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetQuietHoursEnd() int32 {
+	if x != nil && x.QuietHoursEnd != nil {
+		return *x.QuietHoursEnd
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Error         *Error                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetNotificationPreferencesResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Error         *Error                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// ReindexTasksRequest selects the tasks to queue for the search indexer: all
+// of them, one customer's, or task_ids. reset_cursor may be combined with any
+// of these or sent alone.
+type ReindexTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	TaskIds       []string               `protobuf:"bytes,3,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	ResetCursor   bool                   `protobuf:"varint,4,opt,name=reset_cursor,json=resetCursor,proto3" json:"reset_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexTasksRequest) Reset() {
+	*x = ReindexTasksRequest{}
+	mi := &file_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexTasksRequest) ProtoMessage() {}
+
+func (x *ReindexTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexTasksRequest.ProtoReflect.Descriptor instead.
+func (*ReindexTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReindexTasksRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ReindexTasksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ReindexTasksRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *ReindexTasksRequest) GetResetCursor() bool {
+	if x != nil {
+		return x.ResetCursor
+	}
+	return false
+}
+
+type ReindexTasksResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Queued int32                  `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// pending is the number of index jobs still waiting, including earlier ones.
+	Pending       int32  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Error         *Error `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexTasksResponse) Reset() {
+	*x = ReindexTasksResponse{}
+	mi := &file_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexTasksResponse) ProtoMessage() {}
+
+func (x *ReindexTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexTasksResponse.ProtoReflect.Descriptor instead.
+func (*ReindexTasksResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReindexTasksResponse) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ReindexTasksResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ReindexTasksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetIndexerStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexerStatusRequest) Reset() {
+	*x = GetIndexerStatusRequest{}
+	mi := &file_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexerStatusRequest) ProtoMessage() {}
+
+func (x *GetIndexerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{77}
+}
+
+// IndexerStatus describes how far the search index trails the tasks table.
+// Timestamps are unix seconds and 0 when unknown.
+type IndexerStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cursor        int32                  `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	LagSeconds    int32                  `protobuf:"varint,2,opt,name=lag_seconds,json=lagSeconds,proto3" json:"lag_seconds,omitempty"`
+	PendingJobs   int32                  `protobuf:"varint,3,opt,name=pending_jobs,json=pendingJobs,proto3" json:"pending_jobs,omitempty"`
+	DeadJobs      int32                  `protobuf:"varint,4,opt,name=dead_jobs,json=deadJobs,proto3" json:"dead_jobs,omitempty"`
+	LastRunAt     int32                  `protobuf:"varint,5,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastSuccessAt int32                  `protobuf:"varint,6,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt   int32                  `protobuf:"varint,8,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	// Circuit breaker state of the search client on the replica that served
+	// this request: "closed", "open" or "half_open"; empty without a client.
+	BreakerState  string `protobuf:"bytes,9,opt,name=breaker_state,json=breakerState,proto3" json:"breaker_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskByIDRequest) Reset() {
-	*x = GetTaskByIDRequest{}
-	mi := &file_task_proto_msgTypes[17]
+func (x *IndexerStatus) Reset() {
+	*x = IndexerStatus{}
+	mi := &file_task_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskByIDRequest) String() string {
+func (x *IndexerStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskByIDRequest) ProtoMessage() {}
+func (*IndexerStatus) ProtoMessage() {}
 
-func (x *GetTaskByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[17]
+func (x *IndexerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,41 +5700,97 @@ func (x *GetTaskByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskByIDRequest) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use IndexerStatus.ProtoReflect.Descriptor instead.
+func (*IndexerStatus) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetTaskByIDRequest) GetId() string {
+func (x *IndexerStatus) GetCursor() int32 {
 	if x != nil {
-		return x.Id
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetLagSeconds() int32 {
+	if x != nil {
+		return x.LagSeconds
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetPendingJobs() int32 {
+	if x != nil {
+		return x.PendingJobs
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetDeadJobs() int32 {
+	if x != nil {
+		return x.DeadJobs
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetLastRunAt() int32 {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetLastSuccessAt() int32 {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
 	}
 	return ""
 }
 
-type GetTaskByIDResponse struct {
+func (x *IndexerStatus) GetLastErrorAt() int32 {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return 0
+}
+
+func (x *IndexerStatus) GetBreakerState() string {
+	if x != nil {
+		return x.BreakerState
+	}
+	return ""
+}
+
+type GetIndexerStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Status        *IndexerStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskByIDResponse) Reset() {
-	*x = GetTaskByIDResponse{}
-	mi := &file_task_proto_msgTypes[18]
+func (x *GetIndexerStatusResponse) Reset() {
+	*x = GetIndexerStatusResponse{}
+	mi := &file_task_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskByIDResponse) String() string {
+func (x *GetIndexerStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskByIDResponse) ProtoMessage() {}
+func (*GetIndexerStatusResponse) ProtoMessage() {}
 
-func (x *GetTaskByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[18]
+func (x *GetIndexerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,47 +5801,52 @@ func (x *GetTaskByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskByIDResponse) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetIndexerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetTaskByIDResponse) GetTask() *Task {
+func (x *GetIndexerStatusResponse) GetStatus() *IndexerStatus {
 	if x != nil {
-		return x.Task
+		return x.Status
 	}
 	return nil
 }
 
-func (x *GetTaskByIDResponse) GetError() *Error {
+func (x *GetIndexerStatusResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type UpdateTaskRequest struct {
+type IndexJob struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Attempts      int32                  `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     int32                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int32                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_task_proto_msgTypes[19]
+func (x *IndexJob) Reset() {
+	*x = IndexJob{}
+	mi := &file_task_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *IndexJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*IndexJob) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[19]
+func (x *IndexJob) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,41 +5857,76 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use IndexJob.ProtoReflect.Descriptor instead.
+func (*IndexJob) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UpdateTaskRequest) GetTask() *Task {
+func (x *IndexJob) GetId() int64 {
 	if x != nil {
-		return x.Task
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type UpdateTaskResponse struct {
+func (x *IndexJob) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *IndexJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IndexJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *IndexJob) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *IndexJob) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListDeadIndexJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_task_proto_msgTypes[20]
+func (x *ListDeadIndexJobsRequest) Reset() {
+	*x = ListDeadIndexJobsRequest{}
+	mi := &file_task_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *ListDeadIndexJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*ListDeadIndexJobsRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[20]
+func (x *ListDeadIndexJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,47 +5937,49 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListDeadIndexJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadIndexJobsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+func (x *ListDeadIndexJobsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Task
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateTaskResponse) GetError() *Error {
+func (x *ListDeadIndexJobsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.Error
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type DeleteTaskRequest struct {
+type ListDeadIndexJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Jobs          []*IndexJob            `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_task_proto_msgTypes[21]
+func (x *ListDeadIndexJobsResponse) Reset() {
+	*x = ListDeadIndexJobsResponse{}
+	mi := &file_task_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *ListDeadIndexJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*ListDeadIndexJobsResponse) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[21]
+func (x *ListDeadIndexJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,41 +5990,55 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListDeadIndexJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadIndexJobsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DeleteTaskRequest) GetId() string {
+func (x *ListDeadIndexJobsResponse) GetJobs() []*IndexJob {
 	if x != nil {
-		return x.Id
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type DeleteTaskResponse struct {
+func (x *ListDeadIndexJobsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDeadIndexJobsResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// ReplayIndexJobsRequest queues dead jobs again; an empty ids replays all.
+type ReplayIndexJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_task_proto_msgTypes[22]
+func (x *ReplayIndexJobsRequest) Reset() {
+	*x = ReplayIndexJobsRequest{}
+	mi := &file_task_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *ReplayIndexJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*ReplayIndexJobsRequest) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[22]
+func (x *ReplayIndexJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,48 +6049,41 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *DeleteTaskResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ReplayIndexJobsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayIndexJobsRequest) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DeleteTaskResponse) GetError() *Error {
+func (x *ReplayIndexJobsRequest) GetIds() []int64 {
 	if x != nil {
-		return x.Error
+		return x.Ids
 	}
 	return nil
 }
 
-type CreateTaskResponse struct {
+type ReplayIndexJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=Task,proto3" json:"Task,omitempty"`
+	Replayed      int32                  `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_task_proto_msgTypes[23]
+func (x *ReplayIndexJobsResponse) Reset() {
+	*x = ReplayIndexJobsResponse{}
+	mi := &file_task_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *ReplayIndexJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*ReplayIndexJobsResponse) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[23]
+func (x *ReplayIndexJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,19 +6094,19 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReplayIndexJobsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayIndexJobsResponse) Descriptor() ([]byte, []int) {
+	return file_task_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *ReplayIndexJobsResponse) GetReplayed() int32 {
 	if x != nil {
-		return x.Task
+		return x.Replayed
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateTaskResponse) GetError() *Error {
+func (x *ReplayIndexJobsResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
@@ -1410,7 +6123,7 @@ type Error struct {
 
 func (x *Error) Reset() {
 	*x = Error{}
-	mi := &file_task_proto_msgTypes[24]
+	mi := &file_task_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +6135,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_task_proto_msgTypes[24]
+	mi := &file_task_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +6148,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_task_proto_rawDescGZIP(), []int{24}
+	return file_task_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *Error) GetCode() ErrorCode {
@@ -1457,10 +6170,11 @@ var File_task_proto protoreflect.FileDescriptor
 const file_task_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"task.proto\x12\x04task\"G\n" +
+	"task.proto\x12\x04task\"d\n" +
 	"\x13UserJoinTaskRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\"9\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tsearch_id\x18\x03 \x01(\tR\bsearchId\"9\n" +
 	"\x14UserJoinTaskResponse\x12!\n" +
 	"\x05error\x18\x01 \x01(\v2\v.task.ErrorR\x05error\"H\n" +
 	"\x14UserLeaveTaskRequest\x12\x17\n" +
@@ -1482,7 +6196,117 @@ const file_task_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\"7\n" +
 	"\x12RejectTaskResponse\x12!\n" +
-	"\x05error\x18\x01 \x01(\v2\v.task.ErrorR\x05error\"\xc9\x02\n" +
+	"\x05error\x18\x01 \x01(\v2\v.task.ErrorR\x05error\"\xc8\x01\n" +
+	"\bUserTask\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12,\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x14.task.UserTaskStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x05R\tupdatedAt\x12\x1e\n" +
+	"\x04Task\x18\x06 \x01(\v2\n" +
+	".task.TaskR\x04Task\"\x8f\x01\n" +
+	"\x14ListUserTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x14.task.UserTaskStatusR\bstatuses\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x7f\n" +
+	"\x15ListUserTasksResponse\x12-\n" +
+	"\n" +
+	"user_tasks\x18\x01 \x03(\v2\x0e.task.UserTaskR\tuserTasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"\x96\x01\n" +
+	"\x1bListTaskParticipantsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x120\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x14.task.UserTaskStatusR\bstatuses\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x8b\x01\n" +
+	"\x1cListTaskParticipantsResponse\x122\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x0e.task.UserTaskR\fparticipants\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"Q\n" +
+	"\x10WatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12$\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\tR\fafterEventId\"V\n" +
+	"\x15WatchUserTasksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0eafter_event_id\x18\x02 \x01(\tR\fafterEventId\"\xfb\x01\n" +
+	"\n" +
+	"WatchEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.task.WatchEventTypeR\x04type\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\x05R\n" +
+	"occurredAt\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12\x1e\n" +
+	"\x04Task\x18\x05 \x01(\v2\n" +
+	".task.TaskR\x04Task\x12+\n" +
+	"\tuser_task\x18\x06 \x01(\v2\x0e.task.UserTaskR\buserTask\x12!\n" +
+	"\x05error\x18\a \x01(\v2\v.task.ErrorR\x05error\"\x88\x01\n" +
+	"\x17GetCustomerStatsRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12'\n" +
+	"\x06window\x18\x02 \x01(\v2\x0f.task.TimeRangeR\x06window\x12#\n" +
+	"\rinclude_tasks\x18\x03 \x01(\bR\fincludeTasks\"h\n" +
+	"\x18GetCustomerStatsResponse\x12)\n" +
+	"\x05stats\x18\x01 \x01(\v2\x13.task.CustomerStatsR\x05stats\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"Z\n" +
+	"\x19GetSearchAnalyticsRequest\x12'\n" +
+	"\x06window\x18\x01 \x01(\v2\x0f.task.TimeRangeR\x06window\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"t\n" +
+	"\x1aGetSearchAnalyticsResponse\x123\n" +
+	"\tanalytics\x18\x01 \x01(\v2\x15.task.SearchAnalyticsR\tanalytics\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\x98\x03\n" +
+	"\x0fSearchAnalytics\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\x12\x1a\n" +
+	"\bsearches\x18\x03 \x01(\x05R\bsearches\x120\n" +
+	"\x14zero_result_searches\x18\x04 \x01(\x05R\x12zeroResultSearches\x12-\n" +
+	"\x12converted_searches\x18\x05 \x01(\x05R\x11convertedSearches\x12\x14\n" +
+	"\x05joins\x18\x06 \x01(\x05R\x05joins\x12'\n" +
+	"\x0fconversion_rate\x18\a \x01(\x01R\x0econversionRate\x12$\n" +
+	"\x0eavg_latency_ms\x18\b \x01(\x03R\favgLatencyMs\x127\n" +
+	"\vtop_queries\x18\t \x03(\v2\x16.task.SearchQueryStatsR\n" +
+	"topQueries\x12F\n" +
+	"\x13zero_result_queries\x18\n" +
+	" \x03(\v2\x16.task.SearchQueryStatsR\x11zeroResultQueries\"\xc4\x01\n" +
+	"\x10SearchQueryStats\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x05R\bsearches\x12!\n" +
+	"\fzero_results\x18\x03 \x01(\x05R\vzeroResults\x12\x1c\n" +
+	"\tconverted\x18\x04 \x01(\x05R\tconverted\x12\x14\n" +
+	"\x05joins\x18\x05 \x01(\x05R\x05joins\x12'\n" +
+	"\x0fconversion_rate\x18\x06 \x01(\x01R\x0econversionRate\"\xfa\x03\n" +
+	"\rCustomerStats\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x05R\x02to\x12\x1f\n" +
+	"\vtotal_tasks\x18\x04 \x01(\x05R\n" +
+	"totalTasks\x12!\n" +
+	"\factive_tasks\x18\x05 \x01(\x05R\vactiveTasks\x12&\n" +
+	"\x0eparticipations\x18\x06 \x01(\x05R\x0eparticipations\x12/\n" +
+	"\x13unique_participants\x18\a \x01(\x05R\x12uniqueParticipants\x12\x1c\n" +
+	"\tcompleted\x18\b \x01(\x05R\tcompleted\x12\x1a\n" +
+	"\bapproved\x18\t \x01(\x05R\bapproved\x12\x1a\n" +
+	"\brejected\x18\n" +
+	" \x01(\x05R\brejected\x12#\n" +
+	"\rapproval_rate\x18\v \x01(\x01R\fapprovalRate\x12B\n" +
+	"\x1eavg_time_to_completion_seconds\x18\f \x01(\x03R\x1aavgTimeToCompletionSeconds\x12!\n" +
+	"\fpoints_spent\x18\r \x01(\x03R\vpointsSpent\x12%\n" +
+	"\x05tasks\x18\x0e \x03(\v2\x0f.task.TaskStatsR\x05tasks\"\xca\x02\n" +
+	"\tTaskStats\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12\x16\n" +
+	"\x06joined\x18\x04 \x01(\x05R\x06joined\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\x05R\tcompleted\x12\x1a\n" +
+	"\bapproved\x18\x06 \x01(\x05R\bapproved\x12\x1a\n" +
+	"\brejected\x18\a \x01(\x05R\brejected\x12#\n" +
+	"\rapproval_rate\x18\b \x01(\x01R\fapprovalRate\x12B\n" +
+	"\x1eavg_time_to_completion_seconds\x18\t \x01(\x03R\x1aavgTimeToCompletionSeconds\x12!\n" +
+	"\fpoints_spent\x18\n" +
+	" \x01(\x03R\vpointsSpent\"\x88\x03\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\tR\n" +
@@ -1498,33 +6322,84 @@ const file_task_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\x05R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\x05R\tupdatedAt\".\n" +
+	" \x01(\x05R\tupdatedAt\x12=\n" +
+	"\fparticipants\x18\v \x01(\v2\x19.task.ParticipantCountersR\fparticipants\"\xa3\x01\n" +
+	"\x13ParticipantCounters\x12\x18\n" +
+	"\apending\x18\x01 \x01(\x05R\apending\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12\x1a\n" +
+	"\bapproved\x18\x03 \x01(\x05R\bapproved\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x05R\brejected\x12\x1c\n" +
+	"\tcancelled\x18\x05 \x01(\x05R\tcancelled\".\n" +
 	"\x04Meta\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"3\n" +
 	"\x11CreateTaskRequest\x12\x1e\n" +
 	"\x04Task\x18\x01 \x01(\v2\n" +
-	".task.TaskR\x04Task\"`\n" +
+	".task.TaskR\x04Task\"\xb8\x02\n" +
 	"\x0fGetTasksRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\tR\n" +
 	"customerId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"m\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12(\n" +
+	"\x06filter\x18\x04 \x01(\v2\x10.task.TaskFilterR\x06filter\x122\n" +
+	"\n" +
+	"sort_field\x18\x05 \x01(\x0e2\x13.task.TaskSortFieldR\tsortField\x12:\n" +
+	"\x0esort_direction\x18\x06 \x01(\x0e2\x13.task.SortDirectionR\rsortDirection\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\b \x01(\bR\tskipTotal\"\xe9\x02\n" +
+	"\n" +
+	"TaskFilter\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12!\n" +
+	"\fcustomer_ids\x18\x02 \x03(\tR\vcustomerIds\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tname_like\x18\x04 \x01(\tR\bnameLike\x12E\n" +
+	"\x12verification_types\x18\x05 \x03(\x0e2\x16.task.VerificationTypeR\x11verificationTypes\x12\x1e\n" +
+	"\bcost_min\x18\x06 \x01(\x05H\x00R\acostMin\x88\x01\x01\x12\x1e\n" +
+	"\bcost_max\x18\a \x01(\x05H\x01R\acostMax\x88\x01\x01\x12)\n" +
+	"\acreated\x18\b \x01(\v2\x0f.task.TimeRangeR\acreated\x12)\n" +
+	"\aupdated\x18\t \x01(\v2\x0f.task.TimeRangeR\aupdatedB\v\n" +
+	"\t_cost_minB\v\n" +
+	"\t_cost_max\"/\n" +
+	"\tTimeRange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\"\x95\x01\n" +
 	"\x10GetTasksResponse\x12 \n" +
 	"\x05Tasks\x18\x01 \x03(\v2\n" +
 	".task.TaskR\x05Tasks\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
-	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"x\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x8a\x02\n" +
 	"\x12SearchTasksRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
 	"\n" +
 	"query_type\x18\x02 \x01(\tR\tqueryType\x12\x19\n" +
 	"\bgeo_data\x18\x03 \x01(\tR\ageoData\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\"Z\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12*\n" +
+	"\x06filter\x18\b \x01(\v2\x12.task.SearchFilterR\x06filter\x12\x17\n" +
+	"\auser_id\x18\t \x01(\tR\x06userId\"\xce\x02\n" +
+	"\fSearchFilter\x12!\n" +
+	"\fcustomer_ids\x18\x01 \x03(\tR\vcustomerIds\x12E\n" +
+	"\x12verification_types\x18\x02 \x03(\x0e2\x16.task.VerificationTypeR\x11verificationTypes\x12\x1e\n" +
+	"\bcost_min\x18\x03 \x01(\x05H\x00R\acostMin\x88\x01\x01\x12\x1e\n" +
+	"\bcost_max\x18\x04 \x01(\x05H\x01R\acostMax\x88\x01\x01\x12$\n" +
+	"\x0emin_open_slots\x18\x05 \x01(\x05R\fminOpenSlots\x12)\n" +
+	"\acreated\x18\x06 \x01(\v2\x0f.task.TimeRangeR\acreated\x12)\n" +
+	"\aupdated\x18\a \x01(\v2\x0f.task.TimeRangeR\aupdatedB\v\n" +
+	"\t_cost_minB\v\n" +
+	"\t_cost_max\"\xb5\x01\n" +
 	"\x13SearchTasksResponse\x12 \n" +
 	"\x05Tasks\x18\x01 \x03(\v2\n" +
 	".task.TaskR\x05Tasks\x12!\n" +
-	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"$\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12\x1b\n" +
+	"\tsearch_id\x18\x05 \x01(\tR\bsearchId\"$\n" +
 	"\x12GetTaskByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"X\n" +
 	"\x13GetTaskByIDResponse\x12\x1e\n" +
@@ -1546,21 +6421,280 @@ const file_task_proto_rawDesc = "" +
 	"\x12CreateTaskResponse\x12\x1e\n" +
 	"\x04Task\x18\x01 \x01(\v2\n" +
 	".task.TaskR\x04Task\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"j\n" +
+	"\x0fBatchTaskResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1e\n" +
+	"\x04Task\x18\x02 \x01(\v2\n" +
+	".task.TaskR\x04Task\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"(\n" +
+	"\x14BatchGetTasksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"}\n" +
+	"\x15BatchGetTasksResponse\x12 \n" +
+	"\x05Tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05Tasks\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\tR\n" +
+	"missingIds\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"`\n" +
+	"\x17BatchCreateTasksRequest\x12 \n" +
+	"\x05Tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05Tasks\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.task.BatchModeR\x04mode\"n\n" +
+	"\x18BatchCreateTasksResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.task.BatchTaskResultR\aresults\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"`\n" +
+	"\x17BatchUpdateTasksRequest\x12 \n" +
+	"\x05Tasks\x18\x01 \x03(\v2\n" +
+	".task.TaskR\x05Tasks\x12#\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x0f.task.BatchModeR\x04mode\"n\n" +
+	"\x18BatchUpdateTasksResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.task.BatchTaskResultR\aresults\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\xf3\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x127\n" +
+	"\vevent_types\x18\x05 \x03(\x0e2\x16.task.WebhookEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x05R\tupdatedAt\"\xb0\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\tR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x125\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2\x16.task.WebhookEventTypeR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.task.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\x05R\rnextAttemptAt\x12(\n" +
+	"\x10last_status_code\x18\t \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12!\n" +
+	"\fdelivered_at\x18\v \x01(\x05R\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x05R\tcreatedAt\"\x9a\x01\n" +
+	"\x14CreateWebhookRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x127\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2\x16.task.WebhookEventTypeR\n" +
+	"eventTypes\"c\n" +
+	"\x15CreateWebhookResponse\x12'\n" +
+	"\awebhook\x18\x01 \x01(\v2\r.task.WebhookR\awebhook\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\xf5\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tH\x00R\x03url\x88\x01\x01\x127\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2\x16.task.WebhookEventTypeR\n" +
+	"eventTypes\x12,\n" +
+	"\x12update_event_types\x18\x05 \x01(\bR\x10updateEventTypes\x12\x1b\n" +
+	"\x06active\x18\x06 \x01(\bH\x01R\x06active\x88\x01\x01B\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_active\"c\n" +
+	"\x15UpdateWebhookResponse\x12'\n" +
+	"\awebhook\x18\x01 \x01(\v2\r.task.WebhookR\awebhook\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"6\n" +
+	"\x13ListWebhooksRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"d\n" +
+	"\x14ListWebhooksResponse\x12)\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\r.task.WebhookR\bwebhooks\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"G\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\":\n" +
+	"\x15DeleteWebhookResponse\x12!\n" +
+	"\x05error\x18\x01 \x01(\v2\v.task.ErrorR\x05error\"\x8c\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x8f\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x125\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x15.task.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"[\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x03R\n" +
+	"deliveryId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\"p\n" +
+	"\x18RedeliverWebhookResponse\x121\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x15.task.WebhookDeliveryR\bdelivery\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\xe3\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12*\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x16.task.NotificationTypeR\x04type\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x05R\tcreatedAt\"\x8c\x01\n" +
+	"\x18ListNotificationsRequest\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xa6\x01\n" +
+	"\x19ListNotificationsResponse\x128\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x12.task.NotificationR\rnotifications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06unread\x18\x03 \x01(\x05R\x06unread\x12!\n" +
+	"\x05error\x18\x04 \x01(\v2\v.task.ErrorR\x05error\"S\n" +
+	"\x1cMarkNotificationsReadRequest\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\"\\\n" +
+	"\x1dMarkNotificationsReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\x8d\x02\n" +
+	"\x17NotificationPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
+	"\bchannels\x18\x02 \x03(\x0e2\x19.task.NotificationChannelR\bchannels\x12/\n" +
+	"\x11quiet_hours_start\x18\x03 \x01(\x05H\x00R\x0fquietHoursStart\x88\x01\x01\x12+\n" +
+	"\x0fquiet_hours_end\x18\x04 \x01(\x05H\x01R\rquietHoursEnd\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezoneB\x14\n" +
+	"\x12_quiet_hours_startB\x12\n" +
+	"\x10_quiet_hours_end\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x88\x01\n" +
+	"\"GetNotificationPreferencesResponse\x12?\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1d.task.NotificationPreferencesR\vpreferences\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"g\n" +
+	"$UpdateNotificationPreferencesRequest\x12?\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1d.task.NotificationPreferencesR\vpreferences\"\x8b\x01\n" +
+	"%UpdateNotificationPreferencesResponse\x12?\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1d.task.NotificationPreferencesR\vpreferences\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\x86\x01\n" +
+	"\x13ReindexTasksRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x19\n" +
+	"\btask_ids\x18\x03 \x03(\tR\ataskIds\x12!\n" +
+	"\freset_cursor\x18\x04 \x01(\bR\vresetCursor\"k\n" +
+	"\x14ReindexTasksResponse\x12\x16\n" +
+	"\x06queued\x18\x01 \x01(\x05R\x06queued\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"\x19\n" +
+	"\x17GetIndexerStatusRequest\"\xb8\x02\n" +
+	"\rIndexerStatus\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x05R\x06cursor\x12\x1f\n" +
+	"\vlag_seconds\x18\x02 \x01(\x05R\n" +
+	"lagSeconds\x12!\n" +
+	"\fpending_jobs\x18\x03 \x01(\x05R\vpendingJobs\x12\x1b\n" +
+	"\tdead_jobs\x18\x04 \x01(\x05R\bdeadJobs\x12\x1e\n" +
+	"\vlast_run_at\x18\x05 \x01(\x05R\tlastRunAt\x12&\n" +
+	"\x0flast_success_at\x18\x06 \x01(\x05R\rlastSuccessAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12\"\n" +
+	"\rlast_error_at\x18\b \x01(\x05R\vlastErrorAt\x12#\n" +
+	"\rbreaker_state\x18\t \x01(\tR\fbreakerState\"j\n" +
+	"\x18GetIndexerStatusResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x13.task.IndexerStatusR\x06status\x12!\n" +
+	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"\xac\x01\n" +
+	"\bIndexJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x05R\tupdatedAt\"H\n" +
+	"\x18ListDeadIndexJobsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"x\n" +
+	"\x19ListDeadIndexJobsResponse\x12\"\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x0e.task.IndexJobR\x04jobs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12!\n" +
+	"\x05error\x18\x03 \x01(\v2\v.task.ErrorR\x05error\"*\n" +
+	"\x16ReplayIndexJobsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"X\n" +
+	"\x17ReplayIndexJobsResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed\x12!\n" +
 	"\x05error\x18\x02 \x01(\v2\v.task.ErrorR\x05error\"F\n" +
 	"\x05Error\x12#\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x0f.task.ErrorCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*\x89\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xce\x01\n" +
+	"\x0eUserTaskStatus\x12 \n" +
+	"\x1cUSER_TASK_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18USER_TASK_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aUSER_TASK_STATUS_COMPLETED\x10\x02\x12\x1d\n" +
+	"\x19USER_TASK_STATUS_APPROVED\x10\x03\x12\x1d\n" +
+	"\x19USER_TASK_STATUS_REJECTED\x10\x04\x12\x1e\n" +
+	"\x1aUSER_TASK_STATUS_CANCELLED\x10\x05*\xeb\x01\n" +
+	"\x0eWatchEventType\x12 \n" +
+	"\x1cWATCH_EVENT_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWATCH_EVENT_TYPE_TASK_CREATED\x10\x01\x12!\n" +
+	"\x1dWATCH_EVENT_TYPE_TASK_UPDATED\x10\x02\x12!\n" +
+	"\x1dWATCH_EVENT_TYPE_TASK_DELETED\x10\x03\x12&\n" +
+	"\"WATCH_EVENT_TYPE_USER_TASK_CREATED\x10\x04\x12&\n" +
+	"\"WATCH_EVENT_TYPE_USER_TASK_UPDATED\x10\x05*\x89\x01\n" +
 	"\x10VerificationType\x12!\n" +
 	"\x1dVERIFICATION_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15VERIFICATION_TYPE_KYC\x10\x01\x12\x1a\n" +
 	"\x16VERIFICATION_TYPE_NONE\x10\x02\x12\x1b\n" +
-	"\x17VERIFICATION_TYPE_OTHER\x10\x03*\x94\x01\n" +
+	"\x17VERIFICATION_TYPE_OTHER\x10\x03*\xa4\x01\n" +
+	"\rTaskSortField\x12\x1f\n" +
+	"\x1bTASK_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTASK_SORT_FIELD_CREATED_AT\x10\x01\x12\x1e\n" +
+	"\x1aTASK_SORT_FIELD_UPDATED_AT\x10\x02\x12\x18\n" +
+	"\x14TASK_SORT_FIELD_COST\x10\x03\x12\x18\n" +
+	"\x14TASK_SORT_FIELD_NAME\x10\x04*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x01\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x02*V\n" +
+	"\tBatchMode\x12\x1a\n" +
+	"\x16BATCH_MODE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BATCH_MODE_ATOMIC\x10\x01\x12\x16\n" +
+	"\x12BATCH_MODE_PARTIAL\x10\x02*\x92\x02\n" +
+	"\x10WebhookEventType\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
+	"%WEBHOOK_EVENT_TYPE_PARTICIPANT_JOINED\x10\x01\x12,\n" +
+	"(WEBHOOK_EVENT_TYPE_PARTICIPANT_CONFIRMED\x10\x02\x12+\n" +
+	"'WEBHOOK_EVENT_TYPE_PARTICIPANT_APPROVED\x10\x03\x12+\n" +
+	"'WEBHOOK_EVENT_TYPE_PARTICIPANT_REJECTED\x10\x04\x12'\n" +
+	"#WEBHOOK_EVENT_TYPE_PARTICIPANT_LEFT\x10\x05*\xb0\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x03*\xd6\x01\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fNOTIFICATION_TYPE_TASK_APPROVED\x10\x01\x12#\n" +
+	"\x1fNOTIFICATION_TYPE_TASK_REJECTED\x10\x02\x12(\n" +
+	"$NOTIFICATION_TYPE_PARTICIPANT_JOINED\x10\x03\x12+\n" +
+	"'NOTIFICATION_TYPE_PARTICIPANT_CONFIRMED\x10\x04*z\n" +
+	"\x13NotificationChannel\x12$\n" +
+	" NOTIFICATION_CHANNEL_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19NOTIFICATION_CHANNEL_PUSH\x10\x01\x12\x1e\n" +
+	"\x1aNOTIFICATION_CHANNEL_EMAIL\x10\x02*\xac\x01\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ERROR_CODE_VALIDATION\x10\x01\x12\x18\n" +
 	"\x14ERROR_CODE_NOT_FOUND\x10\x02\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\x03\x12\x1d\n" +
-	"\x19ERROR_CODE_ALREADY_EXISTS\x10\x042\xf9\x05\n" +
+	"\x19ERROR_CODE_ALREADY_EXISTS\x10\x04\x12\x16\n" +
+	"\x12ERROR_CODE_EXPIRED\x10\x052\xf7\x14\n" +
 	"\vTaskService\x12?\n" +
 	"\n" +
 	"CreateTask\x12\x17.task.CreateTaskRequest\x1a\x18.task.CreateTaskResponse\x129\n" +
@@ -1576,7 +6710,30 @@ const file_task_proto_rawDesc = "" +
 	"\vApproveTask\x12\x18.task.ApproveTaskRequest\x1a\x19.task.ApproveTaskResponse\x12?\n" +
 	"\n" +
 	"RejectTask\x12\x17.task.RejectTaskRequest\x1a\x18.task.RejectTaskResponse\x12B\n" +
-	"\vSearchTasks\x12\x18.task.SearchTasksRequest\x1a\x19.task.SearchTasksResponseB7Z5DobrikaDev/task-service/internal/generated/proto/taskb\x06proto3"
+	"\vSearchTasks\x12\x18.task.SearchTasksRequest\x1a\x19.task.SearchTasksResponse\x12H\n" +
+	"\rListUserTasks\x12\x1a.task.ListUserTasksRequest\x1a\x1b.task.ListUserTasksResponse\x12]\n" +
+	"\x14ListTaskParticipants\x12!.task.ListTaskParticipantsRequest\x1a\".task.ListTaskParticipantsResponse\x12Q\n" +
+	"\x10GetCustomerStats\x12\x1d.task.GetCustomerStatsRequest\x1a\x1e.task.GetCustomerStatsResponse\x12W\n" +
+	"\x12GetSearchAnalytics\x12\x1f.task.GetSearchAnalyticsRequest\x1a .task.GetSearchAnalyticsResponse\x127\n" +
+	"\tWatchTask\x12\x16.task.WatchTaskRequest\x1a\x10.task.WatchEvent0\x01\x12A\n" +
+	"\x0eWatchUserTasks\x12\x1b.task.WatchUserTasksRequest\x1a\x10.task.WatchEvent0\x01\x12H\n" +
+	"\rBatchGetTasks\x12\x1a.task.BatchGetTasksRequest\x1a\x1b.task.BatchGetTasksResponse\x12Q\n" +
+	"\x10BatchCreateTasks\x12\x1d.task.BatchCreateTasksRequest\x1a\x1e.task.BatchCreateTasksResponse\x12Q\n" +
+	"\x10BatchUpdateTasks\x12\x1d.task.BatchUpdateTasksRequest\x1a\x1e.task.BatchUpdateTasksResponse\x12H\n" +
+	"\rCreateWebhook\x12\x1a.task.CreateWebhookRequest\x1a\x1b.task.CreateWebhookResponse\x12H\n" +
+	"\rUpdateWebhook\x12\x1a.task.UpdateWebhookRequest\x1a\x1b.task.UpdateWebhookResponse\x12E\n" +
+	"\fListWebhooks\x12\x19.task.ListWebhooksRequest\x1a\x1a.task.ListWebhooksResponse\x12H\n" +
+	"\rDeleteWebhook\x12\x1a.task.DeleteWebhookRequest\x1a\x1b.task.DeleteWebhookResponse\x12`\n" +
+	"\x15ListWebhookDeliveries\x12\".task.ListWebhookDeliveriesRequest\x1a#.task.ListWebhookDeliveriesResponse\x12Q\n" +
+	"\x10RedeliverWebhook\x12\x1d.task.RedeliverWebhookRequest\x1a\x1e.task.RedeliverWebhookResponse\x12T\n" +
+	"\x11ListNotifications\x12\x1e.task.ListNotificationsRequest\x1a\x1f.task.ListNotificationsResponse\x12`\n" +
+	"\x15MarkNotificationsRead\x12\".task.MarkNotificationsReadRequest\x1a#.task.MarkNotificationsReadResponse\x12o\n" +
+	"\x1aGetNotificationPreferences\x12'.task.GetNotificationPreferencesRequest\x1a(.task.GetNotificationPreferencesResponse\x12x\n" +
+	"\x1dUpdateNotificationPreferences\x12*.task.UpdateNotificationPreferencesRequest\x1a+.task.UpdateNotificationPreferencesResponse\x12E\n" +
+	"\fReindexTasks\x12\x19.task.ReindexTasksRequest\x1a\x1a.task.ReindexTasksResponse\x12Q\n" +
+	"\x10GetIndexerStatus\x12\x1d.task.GetIndexerStatusRequest\x1a\x1e.task.GetIndexerStatusResponse\x12T\n" +
+	"\x11ListDeadIndexJobs\x12\x1e.task.ListDeadIndexJobsRequest\x1a\x1f.task.ListDeadIndexJobsResponse\x12N\n" +
+	"\x0fReplayIndexJobs\x12\x1c.task.ReplayIndexJobsRequest\x1a\x1d.task.ReplayIndexJobsResponseB7Z5DobrikaDev/task-service/internal/generated/proto/taskb\x06proto3"
 
 var (
 	file_task_proto_rawDescOnce sync.Once
@@ -1590,86 +6747,278 @@ func file_task_proto_rawDescGZIP() []byte {
 	return file_task_proto_rawDescData
 }
 
-var file_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_task_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_task_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_task_proto_goTypes = []any{
-	(VerificationType)(0),           // 0: task.VerificationType
-	(ErrorCode)(0),                  // 1: task.ErrorCode
-	(*UserJoinTaskRequest)(nil),     // 2: task.UserJoinTaskRequest
-	(*UserJoinTaskResponse)(nil),    // 3: task.UserJoinTaskResponse
-	(*UserLeaveTaskRequest)(nil),    // 4: task.UserLeaveTaskRequest
-	(*UserLeaveTaskResponse)(nil),   // 5: task.UserLeaveTaskResponse
-	(*UserConfirmTaskRequest)(nil),  // 6: task.UserConfirmTaskRequest
-	(*UserConfirmTaskResponse)(nil), // 7: task.UserConfirmTaskResponse
-	(*ApproveTaskRequest)(nil),      // 8: task.ApproveTaskRequest
-	(*ApproveTaskResponse)(nil),     // 9: task.ApproveTaskResponse
-	(*RejectTaskRequest)(nil),       // 10: task.RejectTaskRequest
-	(*RejectTaskResponse)(nil),      // 11: task.RejectTaskResponse
-	(*Task)(nil),                    // 12: task.Task
-	(*Meta)(nil),                    // 13: task.Meta
-	(*CreateTaskRequest)(nil),       // 14: task.CreateTaskRequest
-	(*GetTasksRequest)(nil),         // 15: task.GetTasksRequest
-	(*GetTasksResponse)(nil),        // 16: task.GetTasksResponse
-	(*SearchTasksRequest)(nil),      // 17: task.SearchTasksRequest
-	(*SearchTasksResponse)(nil),     // 18: task.SearchTasksResponse
-	(*GetTaskByIDRequest)(nil),      // 19: task.GetTaskByIDRequest
-	(*GetTaskByIDResponse)(nil),     // 20: task.GetTaskByIDResponse
-	(*UpdateTaskRequest)(nil),       // 21: task.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),      // 22: task.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),       // 23: task.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),      // 24: task.DeleteTaskResponse
-	(*CreateTaskResponse)(nil),      // 25: task.CreateTaskResponse
-	(*Error)(nil),                   // 26: task.Error
+	(UserTaskStatus)(0),                           // 0: task.UserTaskStatus
+	(WatchEventType)(0),                           // 1: task.WatchEventType
+	(VerificationType)(0),                         // 2: task.VerificationType
+	(TaskSortField)(0),                            // 3: task.TaskSortField
+	(SortDirection)(0),                            // 4: task.SortDirection
+	(BatchMode)(0),                                // 5: task.BatchMode
+	(WebhookEventType)(0),                         // 6: task.WebhookEventType
+	(WebhookDeliveryStatus)(0),                    // 7: task.WebhookDeliveryStatus
+	(NotificationType)(0),                         // 8: task.NotificationType
+	(NotificationChannel)(0),                      // 9: task.NotificationChannel
+	(ErrorCode)(0),                                // 10: task.ErrorCode
+	(*UserJoinTaskRequest)(nil),                   // 11: task.UserJoinTaskRequest
+	(*UserJoinTaskResponse)(nil),                  // 12: task.UserJoinTaskResponse
+	(*UserLeaveTaskRequest)(nil),                  // 13: task.UserLeaveTaskRequest
+	(*UserLeaveTaskResponse)(nil),                 // 14: task.UserLeaveTaskResponse
+	(*UserConfirmTaskRequest)(nil),                // 15: task.UserConfirmTaskRequest
+	(*UserConfirmTaskResponse)(nil),               // 16: task.UserConfirmTaskResponse
+	(*ApproveTaskRequest)(nil),                    // 17: task.ApproveTaskRequest
+	(*ApproveTaskResponse)(nil),                   // 18: task.ApproveTaskResponse
+	(*RejectTaskRequest)(nil),                     // 19: task.RejectTaskRequest
+	(*RejectTaskResponse)(nil),                    // 20: task.RejectTaskResponse
+	(*UserTask)(nil),                              // 21: task.UserTask
+	(*ListUserTasksRequest)(nil),                  // 22: task.ListUserTasksRequest
+	(*ListUserTasksResponse)(nil),                 // 23: task.ListUserTasksResponse
+	(*ListTaskParticipantsRequest)(nil),           // 24: task.ListTaskParticipantsRequest
+	(*ListTaskParticipantsResponse)(nil),          // 25: task.ListTaskParticipantsResponse
+	(*WatchTaskRequest)(nil),                      // 26: task.WatchTaskRequest
+	(*WatchUserTasksRequest)(nil),                 // 27: task.WatchUserTasksRequest
+	(*WatchEvent)(nil),                            // 28: task.WatchEvent
+	(*GetCustomerStatsRequest)(nil),               // 29: task.GetCustomerStatsRequest
+	(*GetCustomerStatsResponse)(nil),              // 30: task.GetCustomerStatsResponse
+	(*GetSearchAnalyticsRequest)(nil),             // 31: task.GetSearchAnalyticsRequest
+	(*GetSearchAnalyticsResponse)(nil),            // 32: task.GetSearchAnalyticsResponse
+	(*SearchAnalytics)(nil),                       // 33: task.SearchAnalytics
+	(*SearchQueryStats)(nil),                      // 34: task.SearchQueryStats
+	(*CustomerStats)(nil),                         // 35: task.CustomerStats
+	(*TaskStats)(nil),                             // 36: task.TaskStats
+	(*Task)(nil),                                  // 37: task.Task
+	(*ParticipantCounters)(nil),                   // 38: task.ParticipantCounters
+	(*Meta)(nil),                                  // 39: task.Meta
+	(*CreateTaskRequest)(nil),                     // 40: task.CreateTaskRequest
+	(*GetTasksRequest)(nil),                       // 41: task.GetTasksRequest
+	(*TaskFilter)(nil),                            // 42: task.TaskFilter
+	(*TimeRange)(nil),                             // 43: task.TimeRange
+	(*GetTasksResponse)(nil),                      // 44: task.GetTasksResponse
+	(*SearchTasksRequest)(nil),                    // 45: task.SearchTasksRequest
+	(*SearchFilter)(nil),                          // 46: task.SearchFilter
+	(*SearchTasksResponse)(nil),                   // 47: task.SearchTasksResponse
+	(*GetTaskByIDRequest)(nil),                    // 48: task.GetTaskByIDRequest
+	(*GetTaskByIDResponse)(nil),                   // 49: task.GetTaskByIDResponse
+	(*UpdateTaskRequest)(nil),                     // 50: task.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                    // 51: task.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                     // 52: task.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                    // 53: task.DeleteTaskResponse
+	(*CreateTaskResponse)(nil),                    // 54: task.CreateTaskResponse
+	(*BatchTaskResult)(nil),                       // 55: task.BatchTaskResult
+	(*BatchGetTasksRequest)(nil),                  // 56: task.BatchGetTasksRequest
+	(*BatchGetTasksResponse)(nil),                 // 57: task.BatchGetTasksResponse
+	(*BatchCreateTasksRequest)(nil),               // 58: task.BatchCreateTasksRequest
+	(*BatchCreateTasksResponse)(nil),              // 59: task.BatchCreateTasksResponse
+	(*BatchUpdateTasksRequest)(nil),               // 60: task.BatchUpdateTasksRequest
+	(*BatchUpdateTasksResponse)(nil),              // 61: task.BatchUpdateTasksResponse
+	(*Webhook)(nil),                               // 62: task.Webhook
+	(*WebhookDelivery)(nil),                       // 63: task.WebhookDelivery
+	(*CreateWebhookRequest)(nil),                  // 64: task.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                 // 65: task.CreateWebhookResponse
+	(*UpdateWebhookRequest)(nil),                  // 66: task.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),                 // 67: task.UpdateWebhookResponse
+	(*ListWebhooksRequest)(nil),                   // 68: task.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                  // 69: task.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),                  // 70: task.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                 // 71: task.DeleteWebhookResponse
+	(*ListWebhookDeliveriesRequest)(nil),          // 72: task.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),         // 73: task.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),               // 74: task.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),              // 75: task.RedeliverWebhookResponse
+	(*Notification)(nil),                          // 76: task.Notification
+	(*ListNotificationsRequest)(nil),              // 77: task.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),             // 78: task.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),          // 79: task.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),         // 80: task.MarkNotificationsReadResponse
+	(*NotificationPreferences)(nil),               // 81: task.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 82: task.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 83: task.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 84: task.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 85: task.UpdateNotificationPreferencesResponse
+	(*ReindexTasksRequest)(nil),                   // 86: task.ReindexTasksRequest
+	(*ReindexTasksResponse)(nil),                  // 87: task.ReindexTasksResponse
+	(*GetIndexerStatusRequest)(nil),               // 88: task.GetIndexerStatusRequest
+	(*IndexerStatus)(nil),                         // 89: task.IndexerStatus
+	(*GetIndexerStatusResponse)(nil),              // 90: task.GetIndexerStatusResponse
+	(*IndexJob)(nil),                              // 91: task.IndexJob
+	(*ListDeadIndexJobsRequest)(nil),              // 92: task.ListDeadIndexJobsRequest
+	(*ListDeadIndexJobsResponse)(nil),             // 93: task.ListDeadIndexJobsResponse
+	(*ReplayIndexJobsRequest)(nil),                // 94: task.ReplayIndexJobsRequest
+	(*ReplayIndexJobsResponse)(nil),               // 95: task.ReplayIndexJobsResponse
+	(*Error)(nil),                                 // 96: task.Error
 }
 var file_task_proto_depIdxs = []int32{
-	26, // 0: task.UserJoinTaskResponse.error:type_name -> task.Error
-	26, // 1: task.UserLeaveTaskResponse.error:type_name -> task.Error
-	26, // 2: task.UserConfirmTaskResponse.error:type_name -> task.Error
-	26, // 3: task.ApproveTaskResponse.error:type_name -> task.Error
-	26, // 4: task.RejectTaskResponse.error:type_name -> task.Error
-	0,  // 5: task.Task.verification_type:type_name -> task.VerificationType
-	13, // 6: task.Task.meta:type_name -> task.Meta
-	12, // 7: task.CreateTaskRequest.Task:type_name -> task.Task
-	12, // 8: task.GetTasksResponse.Tasks:type_name -> task.Task
-	26, // 9: task.GetTasksResponse.error:type_name -> task.Error
-	12, // 10: task.SearchTasksResponse.Tasks:type_name -> task.Task
-	26, // 11: task.SearchTasksResponse.error:type_name -> task.Error
-	12, // 12: task.GetTaskByIDResponse.Task:type_name -> task.Task
-	26, // 13: task.GetTaskByIDResponse.error:type_name -> task.Error
-	12, // 14: task.UpdateTaskRequest.Task:type_name -> task.Task
-	12, // 15: task.UpdateTaskResponse.Task:type_name -> task.Task
-	26, // 16: task.UpdateTaskResponse.error:type_name -> task.Error
-	26, // 17: task.DeleteTaskResponse.error:type_name -> task.Error
-	12, // 18: task.CreateTaskResponse.Task:type_name -> task.Task
-	26, // 19: task.CreateTaskResponse.error:type_name -> task.Error
-	1,  // 20: task.Error.code:type_name -> task.ErrorCode
-	14, // 21: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
-	15, // 22: task.TaskService.GetTasks:input_type -> task.GetTasksRequest
-	19, // 23: task.TaskService.GetTaskByID:input_type -> task.GetTaskByIDRequest
-	21, // 24: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
-	23, // 25: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
-	2,  // 26: task.TaskService.UserJoinTask:input_type -> task.UserJoinTaskRequest
-	4,  // 27: task.TaskService.UserLeaveTask:input_type -> task.UserLeaveTaskRequest
-	6,  // 28: task.TaskService.UserConfirmTask:input_type -> task.UserConfirmTaskRequest
-	8,  // 29: task.TaskService.ApproveTask:input_type -> task.ApproveTaskRequest
-	10, // 30: task.TaskService.RejectTask:input_type -> task.RejectTaskRequest
-	17, // 31: task.TaskService.SearchTasks:input_type -> task.SearchTasksRequest
-	25, // 32: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
-	16, // 33: task.TaskService.GetTasks:output_type -> task.GetTasksResponse
-	20, // 34: task.TaskService.GetTaskByID:output_type -> task.GetTaskByIDResponse
-	22, // 35: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
-	24, // 36: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
-	3,  // 37: task.TaskService.UserJoinTask:output_type -> task.UserJoinTaskResponse
-	5,  // 38: task.TaskService.UserLeaveTask:output_type -> task.UserLeaveTaskResponse
-	7,  // 39: task.TaskService.UserConfirmTask:output_type -> task.UserConfirmTaskResponse
-	9,  // 40: task.TaskService.ApproveTask:output_type -> task.ApproveTaskResponse
-	11, // 41: task.TaskService.RejectTask:output_type -> task.RejectTaskResponse
-	18, // 42: task.TaskService.SearchTasks:output_type -> task.SearchTasksResponse
-	32, // [32:43] is the sub-list for method output_type
-	21, // [21:32] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	96,  // 0: task.UserJoinTaskResponse.error:type_name -> task.Error
+	96,  // 1: task.UserLeaveTaskResponse.error:type_name -> task.Error
+	96,  // 2: task.UserConfirmTaskResponse.error:type_name -> task.Error
+	96,  // 3: task.ApproveTaskResponse.error:type_name -> task.Error
+	96,  // 4: task.RejectTaskResponse.error:type_name -> task.Error
+	0,   // 5: task.UserTask.status:type_name -> task.UserTaskStatus
+	37,  // 6: task.UserTask.Task:type_name -> task.Task
+	0,   // 7: task.ListUserTasksRequest.statuses:type_name -> task.UserTaskStatus
+	21,  // 8: task.ListUserTasksResponse.user_tasks:type_name -> task.UserTask
+	96,  // 9: task.ListUserTasksResponse.error:type_name -> task.Error
+	0,   // 10: task.ListTaskParticipantsRequest.statuses:type_name -> task.UserTaskStatus
+	21,  // 11: task.ListTaskParticipantsResponse.participants:type_name -> task.UserTask
+	96,  // 12: task.ListTaskParticipantsResponse.error:type_name -> task.Error
+	1,   // 13: task.WatchEvent.type:type_name -> task.WatchEventType
+	37,  // 14: task.WatchEvent.Task:type_name -> task.Task
+	21,  // 15: task.WatchEvent.user_task:type_name -> task.UserTask
+	96,  // 16: task.WatchEvent.error:type_name -> task.Error
+	43,  // 17: task.GetCustomerStatsRequest.window:type_name -> task.TimeRange
+	35,  // 18: task.GetCustomerStatsResponse.stats:type_name -> task.CustomerStats
+	96,  // 19: task.GetCustomerStatsResponse.error:type_name -> task.Error
+	43,  // 20: task.GetSearchAnalyticsRequest.window:type_name -> task.TimeRange
+	33,  // 21: task.GetSearchAnalyticsResponse.analytics:type_name -> task.SearchAnalytics
+	96,  // 22: task.GetSearchAnalyticsResponse.error:type_name -> task.Error
+	34,  // 23: task.SearchAnalytics.top_queries:type_name -> task.SearchQueryStats
+	34,  // 24: task.SearchAnalytics.zero_result_queries:type_name -> task.SearchQueryStats
+	36,  // 25: task.CustomerStats.tasks:type_name -> task.TaskStats
+	2,   // 26: task.Task.verification_type:type_name -> task.VerificationType
+	39,  // 27: task.Task.meta:type_name -> task.Meta
+	38,  // 28: task.Task.participants:type_name -> task.ParticipantCounters
+	37,  // 29: task.CreateTaskRequest.Task:type_name -> task.Task
+	42,  // 30: task.GetTasksRequest.filter:type_name -> task.TaskFilter
+	3,   // 31: task.GetTasksRequest.sort_field:type_name -> task.TaskSortField
+	4,   // 32: task.GetTasksRequest.sort_direction:type_name -> task.SortDirection
+	2,   // 33: task.TaskFilter.verification_types:type_name -> task.VerificationType
+	43,  // 34: task.TaskFilter.created:type_name -> task.TimeRange
+	43,  // 35: task.TaskFilter.updated:type_name -> task.TimeRange
+	37,  // 36: task.GetTasksResponse.Tasks:type_name -> task.Task
+	96,  // 37: task.GetTasksResponse.error:type_name -> task.Error
+	46,  // 38: task.SearchTasksRequest.filter:type_name -> task.SearchFilter
+	2,   // 39: task.SearchFilter.verification_types:type_name -> task.VerificationType
+	43,  // 40: task.SearchFilter.created:type_name -> task.TimeRange
+	43,  // 41: task.SearchFilter.updated:type_name -> task.TimeRange
+	37,  // 42: task.SearchTasksResponse.Tasks:type_name -> task.Task
+	96,  // 43: task.SearchTasksResponse.error:type_name -> task.Error
+	37,  // 44: task.GetTaskByIDResponse.Task:type_name -> task.Task
+	96,  // 45: task.GetTaskByIDResponse.error:type_name -> task.Error
+	37,  // 46: task.UpdateTaskRequest.Task:type_name -> task.Task
+	37,  // 47: task.UpdateTaskResponse.Task:type_name -> task.Task
+	96,  // 48: task.UpdateTaskResponse.error:type_name -> task.Error
+	96,  // 49: task.DeleteTaskResponse.error:type_name -> task.Error
+	37,  // 50: task.CreateTaskResponse.Task:type_name -> task.Task
+	96,  // 51: task.CreateTaskResponse.error:type_name -> task.Error
+	37,  // 52: task.BatchTaskResult.Task:type_name -> task.Task
+	96,  // 53: task.BatchTaskResult.error:type_name -> task.Error
+	37,  // 54: task.BatchGetTasksResponse.Tasks:type_name -> task.Task
+	96,  // 55: task.BatchGetTasksResponse.error:type_name -> task.Error
+	37,  // 56: task.BatchCreateTasksRequest.Tasks:type_name -> task.Task
+	5,   // 57: task.BatchCreateTasksRequest.mode:type_name -> task.BatchMode
+	55,  // 58: task.BatchCreateTasksResponse.results:type_name -> task.BatchTaskResult
+	96,  // 59: task.BatchCreateTasksResponse.error:type_name -> task.Error
+	37,  // 60: task.BatchUpdateTasksRequest.Tasks:type_name -> task.Task
+	5,   // 61: task.BatchUpdateTasksRequest.mode:type_name -> task.BatchMode
+	55,  // 62: task.BatchUpdateTasksResponse.results:type_name -> task.BatchTaskResult
+	96,  // 63: task.BatchUpdateTasksResponse.error:type_name -> task.Error
+	6,   // 64: task.Webhook.event_types:type_name -> task.WebhookEventType
+	6,   // 65: task.WebhookDelivery.event_type:type_name -> task.WebhookEventType
+	7,   // 66: task.WebhookDelivery.status:type_name -> task.WebhookDeliveryStatus
+	6,   // 67: task.CreateWebhookRequest.event_types:type_name -> task.WebhookEventType
+	62,  // 68: task.CreateWebhookResponse.webhook:type_name -> task.Webhook
+	96,  // 69: task.CreateWebhookResponse.error:type_name -> task.Error
+	6,   // 70: task.UpdateWebhookRequest.event_types:type_name -> task.WebhookEventType
+	62,  // 71: task.UpdateWebhookResponse.webhook:type_name -> task.Webhook
+	96,  // 72: task.UpdateWebhookResponse.error:type_name -> task.Error
+	62,  // 73: task.ListWebhooksResponse.webhooks:type_name -> task.Webhook
+	96,  // 74: task.ListWebhooksResponse.error:type_name -> task.Error
+	96,  // 75: task.DeleteWebhookResponse.error:type_name -> task.Error
+	63,  // 76: task.ListWebhookDeliveriesResponse.deliveries:type_name -> task.WebhookDelivery
+	96,  // 77: task.ListWebhookDeliveriesResponse.error:type_name -> task.Error
+	63,  // 78: task.RedeliverWebhookResponse.delivery:type_name -> task.WebhookDelivery
+	96,  // 79: task.RedeliverWebhookResponse.error:type_name -> task.Error
+	8,   // 80: task.Notification.type:type_name -> task.NotificationType
+	76,  // 81: task.ListNotificationsResponse.notifications:type_name -> task.Notification
+	96,  // 82: task.ListNotificationsResponse.error:type_name -> task.Error
+	96,  // 83: task.MarkNotificationsReadResponse.error:type_name -> task.Error
+	9,   // 84: task.NotificationPreferences.channels:type_name -> task.NotificationChannel
+	81,  // 85: task.GetNotificationPreferencesResponse.preferences:type_name -> task.NotificationPreferences
+	96,  // 86: task.GetNotificationPreferencesResponse.error:type_name -> task.Error
+	81,  // 87: task.UpdateNotificationPreferencesRequest.preferences:type_name -> task.NotificationPreferences
+	81,  // 88: task.UpdateNotificationPreferencesResponse.preferences:type_name -> task.NotificationPreferences
+	96,  // 89: task.UpdateNotificationPreferencesResponse.error:type_name -> task.Error
+	96,  // 90: task.ReindexTasksResponse.error:type_name -> task.Error
+	89,  // 91: task.GetIndexerStatusResponse.status:type_name -> task.IndexerStatus
+	96,  // 92: task.GetIndexerStatusResponse.error:type_name -> task.Error
+	91,  // 93: task.ListDeadIndexJobsResponse.jobs:type_name -> task.IndexJob
+	96,  // 94: task.ListDeadIndexJobsResponse.error:type_name -> task.Error
+	96,  // 95: task.ReplayIndexJobsResponse.error:type_name -> task.Error
+	10,  // 96: task.Error.code:type_name -> task.ErrorCode
+	40,  // 97: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
+	41,  // 98: task.TaskService.GetTasks:input_type -> task.GetTasksRequest
+	48,  // 99: task.TaskService.GetTaskByID:input_type -> task.GetTaskByIDRequest
+	50,  // 100: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
+	52,  // 101: task.TaskService.DeleteTask:input_type -> task.DeleteTaskRequest
+	11,  // 102: task.TaskService.UserJoinTask:input_type -> task.UserJoinTaskRequest
+	13,  // 103: task.TaskService.UserLeaveTask:input_type -> task.UserLeaveTaskRequest
+	15,  // 104: task.TaskService.UserConfirmTask:input_type -> task.UserConfirmTaskRequest
+	17,  // 105: task.TaskService.ApproveTask:input_type -> task.ApproveTaskRequest
+	19,  // 106: task.TaskService.RejectTask:input_type -> task.RejectTaskRequest
+	45,  // 107: task.TaskService.SearchTasks:input_type -> task.SearchTasksRequest
+	22,  // 108: task.TaskService.ListUserTasks:input_type -> task.ListUserTasksRequest
+	24,  // 109: task.TaskService.ListTaskParticipants:input_type -> task.ListTaskParticipantsRequest
+	29,  // 110: task.TaskService.GetCustomerStats:input_type -> task.GetCustomerStatsRequest
+	31,  // 111: task.TaskService.GetSearchAnalytics:input_type -> task.GetSearchAnalyticsRequest
+	26,  // 112: task.TaskService.WatchTask:input_type -> task.WatchTaskRequest
+	27,  // 113: task.TaskService.WatchUserTasks:input_type -> task.WatchUserTasksRequest
+	56,  // 114: task.TaskService.BatchGetTasks:input_type -> task.BatchGetTasksRequest
+	58,  // 115: task.TaskService.BatchCreateTasks:input_type -> task.BatchCreateTasksRequest
+	60,  // 116: task.TaskService.BatchUpdateTasks:input_type -> task.BatchUpdateTasksRequest
+	64,  // 117: task.TaskService.CreateWebhook:input_type -> task.CreateWebhookRequest
+	66,  // 118: task.TaskService.UpdateWebhook:input_type -> task.UpdateWebhookRequest
+	68,  // 119: task.TaskService.ListWebhooks:input_type -> task.ListWebhooksRequest
+	70,  // 120: task.TaskService.DeleteWebhook:input_type -> task.DeleteWebhookRequest
+	72,  // 121: task.TaskService.ListWebhookDeliveries:input_type -> task.ListWebhookDeliveriesRequest
+	74,  // 122: task.TaskService.RedeliverWebhook:input_type -> task.RedeliverWebhookRequest
+	77,  // 123: task.TaskService.ListNotifications:input_type -> task.ListNotificationsRequest
+	79,  // 124: task.TaskService.MarkNotificationsRead:input_type -> task.MarkNotificationsReadRequest
+	82,  // 125: task.TaskService.GetNotificationPreferences:input_type -> task.GetNotificationPreferencesRequest
+	84,  // 126: task.TaskService.UpdateNotificationPreferences:input_type -> task.UpdateNotificationPreferencesRequest
+	86,  // 127: task.TaskService.ReindexTasks:input_type -> task.ReindexTasksRequest
+	88,  // 128: task.TaskService.GetIndexerStatus:input_type -> task.GetIndexerStatusRequest
+	92,  // 129: task.TaskService.ListDeadIndexJobs:input_type -> task.ListDeadIndexJobsRequest
+	94,  // 130: task.TaskService.ReplayIndexJobs:input_type -> task.ReplayIndexJobsRequest
+	54,  // 131: task.TaskService.CreateTask:output_type -> task.CreateTaskResponse
+	44,  // 132: task.TaskService.GetTasks:output_type -> task.GetTasksResponse
+	49,  // 133: task.TaskService.GetTaskByID:output_type -> task.GetTaskByIDResponse
+	51,  // 134: task.TaskService.UpdateTask:output_type -> task.UpdateTaskResponse
+	53,  // 135: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
+	12,  // 136: task.TaskService.UserJoinTask:output_type -> task.UserJoinTaskResponse
+	14,  // 137: task.TaskService.UserLeaveTask:output_type -> task.UserLeaveTaskResponse
+	16,  // 138: task.TaskService.UserConfirmTask:output_type -> task.UserConfirmTaskResponse
+	18,  // 139: task.TaskService.ApproveTask:output_type -> task.ApproveTaskResponse
+	20,  // 140: task.TaskService.RejectTask:output_type -> task.RejectTaskResponse
+	47,  // 141: task.TaskService.SearchTasks:output_type -> task.SearchTasksResponse
+	23,  // 142: task.TaskService.ListUserTasks:output_type -> task.ListUserTasksResponse
+	25,  // 143: task.TaskService.ListTaskParticipants:output_type -> task.ListTaskParticipantsResponse
+	30,  // 144: task.TaskService.GetCustomerStats:output_type -> task.GetCustomerStatsResponse
+	32,  // 145: task.TaskService.GetSearchAnalytics:output_type -> task.GetSearchAnalyticsResponse
+	28,  // 146: task.TaskService.WatchTask:output_type -> task.WatchEvent
+	28,  // 147: task.TaskService.WatchUserTasks:output_type -> task.WatchEvent
+	57,  // 148: task.TaskService.BatchGetTasks:output_type -> task.BatchGetTasksResponse
+	59,  // 149: task.TaskService.BatchCreateTasks:output_type -> task.BatchCreateTasksResponse
+	61,  // 150: task.TaskService.BatchUpdateTasks:output_type -> task.BatchUpdateTasksResponse
+	65,  // 151: task.TaskService.CreateWebhook:output_type -> task.CreateWebhookResponse
+	67,  // 152: task.TaskService.UpdateWebhook:output_type -> task.UpdateWebhookResponse
+	69,  // 153: task.TaskService.ListWebhooks:output_type -> task.ListWebhooksResponse
+	71,  // 154: task.TaskService.DeleteWebhook:output_type -> task.DeleteWebhookResponse
+	73,  // 155: task.TaskService.ListWebhookDeliveries:output_type -> task.ListWebhookDeliveriesResponse
+	75,  // 156: task.TaskService.RedeliverWebhook:output_type -> task.RedeliverWebhookResponse
+	78,  // 157: task.TaskService.ListNotifications:output_type -> task.ListNotificationsResponse
+	80,  // 158: task.TaskService.MarkNotificationsRead:output_type -> task.MarkNotificationsReadResponse
+	83,  // 159: task.TaskService.GetNotificationPreferences:output_type -> task.GetNotificationPreferencesResponse
+	85,  // 160: task.TaskService.UpdateNotificationPreferences:output_type -> task.UpdateNotificationPreferencesResponse
+	87,  // 161: task.TaskService.ReindexTasks:output_type -> task.ReindexTasksResponse
+	90,  // 162: task.TaskService.GetIndexerStatus:output_type -> task.GetIndexerStatusResponse
+	93,  // 163: task.TaskService.ListDeadIndexJobs:output_type -> task.ListDeadIndexJobsResponse
+	95,  // 164: task.TaskService.ReplayIndexJobs:output_type -> task.ReplayIndexJobsResponse
+	131, // [131:165] is the sub-list for method output_type
+	97,  // [97:131] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
 }
 
 func init() { file_task_proto_init() }
@@ -1677,13 +7026,17 @@ func file_task_proto_init() {
 	if File_task_proto != nil {
 		return
 	}
+	file_task_proto_msgTypes[31].OneofWrappers = []any{}
+	file_task_proto_msgTypes[35].OneofWrappers = []any{}
+	file_task_proto_msgTypes[55].OneofWrappers = []any{}
+	file_task_proto_msgTypes[70].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_proto_rawDesc), len(file_task_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   25,
+			NumEnums:      11,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
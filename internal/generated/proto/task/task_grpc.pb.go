@@ -19,17 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_CreateTask_FullMethodName      = "/task.TaskService/CreateTask"
-	TaskService_GetTasks_FullMethodName        = "/task.TaskService/GetTasks"
-	TaskService_GetTaskByID_FullMethodName     = "/task.TaskService/GetTaskByID"
-	TaskService_UpdateTask_FullMethodName      = "/task.TaskService/UpdateTask"
-	TaskService_DeleteTask_FullMethodName      = "/task.TaskService/DeleteTask"
-	TaskService_UserJoinTask_FullMethodName    = "/task.TaskService/UserJoinTask"
-	TaskService_UserLeaveTask_FullMethodName   = "/task.TaskService/UserLeaveTask"
-	TaskService_UserConfirmTask_FullMethodName = "/task.TaskService/UserConfirmTask"
-	TaskService_ApproveTask_FullMethodName     = "/task.TaskService/ApproveTask"
-	TaskService_RejectTask_FullMethodName      = "/task.TaskService/RejectTask"
-	TaskService_SearchTasks_FullMethodName     = "/task.TaskService/SearchTasks"
+	TaskService_CreateTask_FullMethodName                    = "/task.TaskService/CreateTask"
+	TaskService_GetTasks_FullMethodName                      = "/task.TaskService/GetTasks"
+	TaskService_GetTaskByID_FullMethodName                   = "/task.TaskService/GetTaskByID"
+	TaskService_UpdateTask_FullMethodName                    = "/task.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName                    = "/task.TaskService/DeleteTask"
+	TaskService_UserJoinTask_FullMethodName                  = "/task.TaskService/UserJoinTask"
+	TaskService_UserLeaveTask_FullMethodName                 = "/task.TaskService/UserLeaveTask"
+	TaskService_UserConfirmTask_FullMethodName               = "/task.TaskService/UserConfirmTask"
+	TaskService_ApproveTask_FullMethodName                   = "/task.TaskService/ApproveTask"
+	TaskService_RejectTask_FullMethodName                    = "/task.TaskService/RejectTask"
+	TaskService_SearchTasks_FullMethodName                   = "/task.TaskService/SearchTasks"
+	TaskService_ListUserTasks_FullMethodName                 = "/task.TaskService/ListUserTasks"
+	TaskService_ListTaskParticipants_FullMethodName          = "/task.TaskService/ListTaskParticipants"
+	TaskService_GetCustomerStats_FullMethodName              = "/task.TaskService/GetCustomerStats"
+	TaskService_GetSearchAnalytics_FullMethodName            = "/task.TaskService/GetSearchAnalytics"
+	TaskService_WatchTask_FullMethodName                     = "/task.TaskService/WatchTask"
+	TaskService_WatchUserTasks_FullMethodName                = "/task.TaskService/WatchUserTasks"
+	TaskService_BatchGetTasks_FullMethodName                 = "/task.TaskService/BatchGetTasks"
+	TaskService_BatchCreateTasks_FullMethodName              = "/task.TaskService/BatchCreateTasks"
+	TaskService_BatchUpdateTasks_FullMethodName              = "/task.TaskService/BatchUpdateTasks"
+	TaskService_CreateWebhook_FullMethodName                 = "/task.TaskService/CreateWebhook"
+	TaskService_UpdateWebhook_FullMethodName                 = "/task.TaskService/UpdateWebhook"
+	TaskService_ListWebhooks_FullMethodName                  = "/task.TaskService/ListWebhooks"
+	TaskService_DeleteWebhook_FullMethodName                 = "/task.TaskService/DeleteWebhook"
+	TaskService_ListWebhookDeliveries_FullMethodName         = "/task.TaskService/ListWebhookDeliveries"
+	TaskService_RedeliverWebhook_FullMethodName              = "/task.TaskService/RedeliverWebhook"
+	TaskService_ListNotifications_FullMethodName             = "/task.TaskService/ListNotifications"
+	TaskService_MarkNotificationsRead_FullMethodName         = "/task.TaskService/MarkNotificationsRead"
+	TaskService_GetNotificationPreferences_FullMethodName    = "/task.TaskService/GetNotificationPreferences"
+	TaskService_UpdateNotificationPreferences_FullMethodName = "/task.TaskService/UpdateNotificationPreferences"
+	TaskService_ReindexTasks_FullMethodName                  = "/task.TaskService/ReindexTasks"
+	TaskService_GetIndexerStatus_FullMethodName              = "/task.TaskService/GetIndexerStatus"
+	TaskService_ListDeadIndexJobs_FullMethodName             = "/task.TaskService/ListDeadIndexJobs"
+	TaskService_ReplayIndexJobs_FullMethodName               = "/task.TaskService/ReplayIndexJobs"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -47,6 +70,29 @@ type TaskServiceClient interface {
 	ApproveTask(ctx context.Context, in *ApproveTaskRequest, opts ...grpc.CallOption) (*ApproveTaskResponse, error)
 	RejectTask(ctx context.Context, in *RejectTaskRequest, opts ...grpc.CallOption) (*RejectTaskResponse, error)
 	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
+	ListUserTasks(ctx context.Context, in *ListUserTasksRequest, opts ...grpc.CallOption) (*ListUserTasksResponse, error)
+	ListTaskParticipants(ctx context.Context, in *ListTaskParticipantsRequest, opts ...grpc.CallOption) (*ListTaskParticipantsResponse, error)
+	GetCustomerStats(ctx context.Context, in *GetCustomerStatsRequest, opts ...grpc.CallOption) (*GetCustomerStatsResponse, error)
+	GetSearchAnalytics(ctx context.Context, in *GetSearchAnalyticsRequest, opts ...grpc.CallOption) (*GetSearchAnalyticsResponse, error)
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
+	WatchUserTasks(ctx context.Context, in *WatchUserTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
+	BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*BatchGetTasksResponse, error)
+	BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+	BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
+	ReindexTasks(ctx context.Context, in *ReindexTasksRequest, opts ...grpc.CallOption) (*ReindexTasksResponse, error)
+	GetIndexerStatus(ctx context.Context, in *GetIndexerStatusRequest, opts ...grpc.CallOption) (*GetIndexerStatusResponse, error)
+	ListDeadIndexJobs(ctx context.Context, in *ListDeadIndexJobsRequest, opts ...grpc.CallOption) (*ListDeadIndexJobsResponse, error)
+	ReplayIndexJobs(ctx context.Context, in *ReplayIndexJobsRequest, opts ...grpc.CallOption) (*ReplayIndexJobsResponse, error)
 }
 
 type taskServiceClient struct {
@@ -167,6 +213,254 @@ func (c *taskServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequ
 	return out, nil
 }
 
+func (c *taskServiceClient) ListUserTasks(ctx context.Context, in *ListUserTasksRequest, opts ...grpc.CallOption) (*ListUserTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListUserTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskParticipants(ctx context.Context, in *ListTaskParticipantsRequest, opts ...grpc.CallOption) (*ListTaskParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskParticipantsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetCustomerStats(ctx context.Context, in *GetCustomerStatsRequest, opts ...grpc.CallOption) (*GetCustomerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerStatsResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetCustomerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetSearchAnalytics(ctx context.Context, in *GetSearchAnalyticsRequest, opts ...grpc.CallOption) (*GetSearchAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetSearchAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], TaskService_WatchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTaskRequest, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTaskClient = grpc.ServerStreamingClient[WatchEvent]
+
+func (c *taskServiceClient) WatchUserTasks(ctx context.Context, in *WatchUserTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[1], TaskService_WatchUserTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchUserTasksRequest, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchUserTasksClient = grpc.ServerStreamingClient[WatchEvent]
+
+func (c *taskServiceClient) BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*BatchGetTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchGetTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchCreateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchUpdateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, TaskService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, TaskService_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, TaskService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReindexTasks(ctx context.Context, in *ReindexTasksRequest, opts ...grpc.CallOption) (*ReindexTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReindexTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReindexTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetIndexerStatus(ctx context.Context, in *GetIndexerStatusRequest, opts ...grpc.CallOption) (*GetIndexerStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIndexerStatusResponse)
+	err := c.cc.Invoke(ctx, TaskService_GetIndexerStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListDeadIndexJobs(ctx context.Context, in *ListDeadIndexJobsRequest, opts ...grpc.CallOption) (*ListDeadIndexJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadIndexJobsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListDeadIndexJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReplayIndexJobs(ctx context.Context, in *ReplayIndexJobsRequest, opts ...grpc.CallOption) (*ReplayIndexJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayIndexJobsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReplayIndexJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -182,6 +476,29 @@ type TaskServiceServer interface {
 	ApproveTask(context.Context, *ApproveTaskRequest) (*ApproveTaskResponse, error)
 	RejectTask(context.Context, *RejectTaskRequest) (*RejectTaskResponse, error)
 	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	ListUserTasks(context.Context, *ListUserTasksRequest) (*ListUserTasksResponse, error)
+	ListTaskParticipants(context.Context, *ListTaskParticipantsRequest) (*ListTaskParticipantsResponse, error)
+	GetCustomerStats(context.Context, *GetCustomerStatsRequest) (*GetCustomerStatsResponse, error)
+	GetSearchAnalytics(context.Context, *GetSearchAnalyticsRequest) (*GetSearchAnalyticsResponse, error)
+	WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[WatchEvent]) error
+	WatchUserTasks(*WatchUserTasksRequest, grpc.ServerStreamingServer[WatchEvent]) error
+	BatchGetTasks(context.Context, *BatchGetTasksRequest) (*BatchGetTasksResponse, error)
+	BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
+	ReindexTasks(context.Context, *ReindexTasksRequest) (*ReindexTasksResponse, error)
+	GetIndexerStatus(context.Context, *GetIndexerStatusRequest) (*GetIndexerStatusResponse, error)
+	ListDeadIndexJobs(context.Context, *ListDeadIndexJobsRequest) (*ListDeadIndexJobsResponse, error)
+	ReplayIndexJobs(context.Context, *ReplayIndexJobsRequest) (*ReplayIndexJobsResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -225,6 +542,75 @@ func (UnimplementedTaskServiceServer) RejectTask(context.Context, *RejectTaskReq
 func (UnimplementedTaskServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) ListUserTasks(context.Context, *ListUserTasksRequest) (*ListUserTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskParticipants(context.Context, *ListTaskParticipantsRequest) (*ListTaskParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskParticipants not implemented")
+}
+func (UnimplementedTaskServiceServer) GetCustomerStats(context.Context, *GetCustomerStatsRequest) (*GetCustomerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerStats not implemented")
+}
+func (UnimplementedTaskServiceServer) GetSearchAnalytics(context.Context, *GetSearchAnalyticsRequest) (*GetSearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchAnalytics not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTask not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchUserTasks(*WatchUserTasksRequest, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUserTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchGetTasks(context.Context, *BatchGetTasksRequest) (*BatchGetTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedTaskServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedTaskServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedTaskServiceServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedTaskServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedTaskServiceServer) ReindexTasks(context.Context, *ReindexTasksRequest) (*ReindexTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) GetIndexerStatus(context.Context, *GetIndexerStatusRequest) (*GetIndexerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexerStatus not implemented")
+}
+func (UnimplementedTaskServiceServer) ListDeadIndexJobs(context.Context, *ListDeadIndexJobsRequest) (*ListDeadIndexJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadIndexJobs not implemented")
+}
+func (UnimplementedTaskServiceServer) ReplayIndexJobs(context.Context, *ReplayIndexJobsRequest) (*ReplayIndexJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayIndexJobs not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -444,58 +830,553 @@ func _TaskService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TaskService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "task.TaskService",
-	HandlerType: (*TaskServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTask",
-			Handler:    _TaskService_CreateTask_Handler,
-		},
-		{
-			MethodName: "GetTasks",
-			Handler:    _TaskService_GetTasks_Handler,
-		},
-		{
-			MethodName: "GetTaskByID",
-			Handler:    _TaskService_GetTaskByID_Handler,
-		},
-		{
-			MethodName: "UpdateTask",
-			Handler:    _TaskService_UpdateTask_Handler,
-		},
-		{
-			MethodName: "DeleteTask",
-			Handler:    _TaskService_DeleteTask_Handler,
-		},
-		{
-			MethodName: "UserJoinTask",
-			Handler:    _TaskService_UserJoinTask_Handler,
-		},
-		{
-			MethodName: "UserLeaveTask",
-			Handler:    _TaskService_UserLeaveTask_Handler,
-		},
-		{
-			MethodName: "UserConfirmTask",
-			Handler:    _TaskService_UserConfirmTask_Handler,
-		},
-		{
-			MethodName: "ApproveTask",
-			Handler:    _TaskService_ApproveTask_Handler,
-		},
-		{
-			MethodName: "RejectTask",
-			Handler:    _TaskService_RejectTask_Handler,
-		},
-		{
-			MethodName: "SearchTasks",
-			Handler:    _TaskService_SearchTasks_Handler,
+func _TaskService_ListUserTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListUserTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListUserTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListUserTasks(ctx, req.(*ListUserTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskParticipants(ctx, req.(*ListTaskParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetCustomerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetCustomerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetCustomerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetCustomerStats(ctx, req.(*GetCustomerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetSearchAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetSearchAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetSearchAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetSearchAnalytics(ctx, req.(*GetSearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_WatchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchTask(m, &grpc.GenericServerStream[WatchTaskRequest, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTaskServer = grpc.ServerStreamingServer[WatchEvent]
+
+func _TaskService_WatchUserTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchUserTasks(m, &grpc.GenericServerStream[WatchUserTasksRequest, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchUserTasksServer = grpc.ServerStreamingServer[WatchEvent]
+
+func _TaskService_BatchGetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchGetTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, req.(*BatchGetTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchCreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchCreateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, req.(*BatchCreateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchUpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchUpdateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchUpdateTasks(ctx, req.(*BatchUpdateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReindexTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReindexTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReindexTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReindexTasks(ctx, req.(*ReindexTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetIndexerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetIndexerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetIndexerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetIndexerStatus(ctx, req.(*GetIndexerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListDeadIndexJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadIndexJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListDeadIndexJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListDeadIndexJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListDeadIndexJobs(ctx, req.(*ListDeadIndexJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReplayIndexJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayIndexJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReplayIndexJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReplayIndexJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReplayIndexJobs(ctx, req.(*ReplayIndexJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "task.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _TaskService_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTasks",
+			Handler:    _TaskService_GetTasks_Handler,
+		},
+		{
+			MethodName: "GetTaskByID",
+			Handler:    _TaskService_GetTaskByID_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _TaskService_UpdateTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _TaskService_DeleteTask_Handler,
+		},
+		{
+			MethodName: "UserJoinTask",
+			Handler:    _TaskService_UserJoinTask_Handler,
+		},
+		{
+			MethodName: "UserLeaveTask",
+			Handler:    _TaskService_UserLeaveTask_Handler,
+		},
+		{
+			MethodName: "UserConfirmTask",
+			Handler:    _TaskService_UserConfirmTask_Handler,
+		},
+		{
+			MethodName: "ApproveTask",
+			Handler:    _TaskService_ApproveTask_Handler,
+		},
+		{
+			MethodName: "RejectTask",
+			Handler:    _TaskService_RejectTask_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TaskService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "ListUserTasks",
+			Handler:    _TaskService_ListUserTasks_Handler,
+		},
+		{
+			MethodName: "ListTaskParticipants",
+			Handler:    _TaskService_ListTaskParticipants_Handler,
+		},
+		{
+			MethodName: "GetCustomerStats",
+			Handler:    _TaskService_GetCustomerStats_Handler,
+		},
+		{
+			MethodName: "GetSearchAnalytics",
+			Handler:    _TaskService_GetSearchAnalytics_Handler,
+		},
+		{
+			MethodName: "BatchGetTasks",
+			Handler:    _TaskService_BatchGetTasks_Handler,
+		},
+		{
+			MethodName: "BatchCreateTasks",
+			Handler:    _TaskService_BatchCreateTasks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTasks",
+			Handler:    _TaskService_BatchUpdateTasks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _TaskService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _TaskService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _TaskService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _TaskService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _TaskService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _TaskService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _TaskService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _TaskService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _TaskService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _TaskService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ReindexTasks",
+			Handler:    _TaskService_ReindexTasks_Handler,
+		},
+		{
+			MethodName: "GetIndexerStatus",
+			Handler:    _TaskService_GetIndexerStatus_Handler,
+		},
+		{
+			MethodName: "ListDeadIndexJobs",
+			Handler:    _TaskService_ListDeadIndexJobs_Handler,
+		},
+		{
+			MethodName: "ReplayIndexJobs",
+			Handler:    _TaskService_ReplayIndexJobs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTask",
+			Handler:       _TaskService_WatchTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchUserTasks",
+			Handler:       _TaskService_WatchUserTasks_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "task.proto",
 }
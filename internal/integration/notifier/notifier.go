@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// Notifier pushes a notification to one external channel. Implementations
+// may be called more than once for the same notification.
+type Notifier interface {
+	Notify(ctx context.Context, channel domain.NotificationChannel, notification *domain.Notification) error
+}
+
+// ConsoleNotifier writes notifications to the application log; it is meant
+// for local runs until real push and email providers are wired in.
+type ConsoleNotifier struct {
+	logger *zap.Logger
+}
+
+func NewConsoleNotifier(logger *zap.Logger) *ConsoleNotifier {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &ConsoleNotifier{logger: logger}
+}
+
+func (n *ConsoleNotifier) Notify(_ context.Context, channel domain.NotificationChannel, notification *domain.Notification) error {
+	n.logger.Info(
+		"notification sent",
+		zap.String("channel", channel.String()),
+		zap.String("recipient_id", notification.RecipientID),
+		zap.String("type", notification.Type.String()),
+		zap.String("title", notification.Title),
+		zap.String("body", notification.Body),
+	)
+	return nil
+}
+
+type Sent struct {
+	Channel      domain.NotificationChannel
+	Notification domain.Notification
+}
+
+// MemoryNotifier records notifications in memory for local runs and tests.
+type MemoryNotifier struct {
+	mu   sync.Mutex
+	sent []Sent
+}
+
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) Notify(_ context.Context, channel domain.NotificationChannel, notification *domain.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, Sent{Channel: channel, Notification: *notification})
+	return nil
+}
+
+func (n *MemoryNotifier) Sent() []Sent {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Sent(nil), n.sent...)
+}
@@ -0,0 +1,195 @@
+package search
+
+import (
+	"container/list"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheMaxEntries = 1000
+
+// ResultCache stores search responses by CacheKey. InvalidateTasks drops every
+// entry whose result mentions one of the given task ids.
+type ResultCache interface {
+	Get(key string) (*SearchResponse, bool)
+	Set(key string, resp *SearchResponse)
+	InvalidateTasks(taskIDs ...string)
+}
+
+// CacheKey normalises req so that requests differing only in case, spacing,
+// tag order or filter value order share an entry.
+func CacheKey(req SearchRequest) string {
+	tags := make([]string, 0, len(req.UserTags))
+	for _, tag := range req.UserTags {
+		tag = normaliseCacheField(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+
+	parts := []string{
+		normaliseCacheField(req.UserQuery),
+		normaliseCacheField(req.QueryType),
+		normaliseCacheField(req.GeoData),
+		strings.Join(tags, ","),
+		strconv.Itoa(req.Offset),
+		strconv.Itoa(req.Limit),
+		req.Filter.Key(),
+	}
+	return strings.Join(parts, "\x00")
+}
+
+func normaliseCacheField(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), " "))
+}
+
+// Cached serves repeated searches from cache and stores the answers of
+// searcher in it. Errors are never cached.
+type Cached struct {
+	searcher Searcher
+	cache    ResultCache
+}
+
+func NewCached(searcher Searcher, cache ResultCache) *Cached {
+	return &Cached{searcher: searcher, cache: cache}
+}
+
+func (c *Cached) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	key := CacheKey(req)
+	if resp, ok := c.cache.Get(key); ok {
+		return resp, nil
+	}
+
+	resp, err := c.searcher.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil {
+		c.cache.Set(key, resp)
+	}
+	return resp, nil
+}
+
+type cacheEntry struct {
+	key       string
+	resp      SearchResponse
+	expiresAt time.Time
+}
+
+// MemoryCache is an in-process ResultCache bounded by a TTL and a maximum
+// number of entries; the least recently used entry is evicted first. New
+// tasks are not matched against cached queries, so they show up once the
+// entries expire. Each replica holds its own MemoryCache; changes made by the
+// indexer reach it through jobs/searchcache, so a replica can serve results
+// that are stale by up to one invalidation interval.
+type MemoryCache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+	byTask  map[string]map[string]struct{}
+}
+
+func NewMemoryCache(ttl time.Duration, maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheMaxEntries
+	}
+
+	return &MemoryCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+		byTask:     make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *MemoryCache) Get(key string) (*SearchResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	resp := entry.resp
+	resp.TaskIDs = slices.Clone(entry.resp.TaskIDs)
+	resp.AppliedFilters = slices.Clone(entry.resp.AppliedFilters)
+	return &resp, true
+}
+
+func (c *MemoryCache) Set(key string, resp *SearchResponse) {
+	if resp == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+
+	entry := &cacheEntry{key: key, resp: *resp, expiresAt: c.now().Add(c.ttl)}
+	entry.resp.TaskIDs = slices.Clone(resp.TaskIDs)
+	entry.resp.AppliedFilters = slices.Clone(resp.AppliedFilters)
+	c.entries[key] = c.order.PushFront(entry)
+
+	for _, id := range entry.resp.TaskIDs {
+		keys, ok := c.byTask[id]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.byTask[id] = keys
+		}
+		keys[key] = struct{}{}
+	}
+
+	for c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *MemoryCache) InvalidateTasks(taskIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, id := range taskIDs {
+		for key := range c.byTask[id] {
+			if element, ok := c.entries[key]; ok {
+				c.remove(element)
+			}
+		}
+	}
+}
+
+// remove drops element and its reverse index entries. c.mu must be held.
+func (c *MemoryCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*cacheEntry)
+	delete(c.entries, entry.key)
+
+	for _, id := range entry.resp.TaskIDs {
+		keys := c.byTask[id]
+		delete(keys, entry.key)
+		if len(keys) == 0 {
+			delete(c.byTask, id)
+		}
+	}
+}
@@ -3,29 +3,48 @@ package search
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"time"
 
+	searchpb "DobrikaDev/task-service/internal/generated/proto/search"
 	"DobrikaDev/task-service/utils/config"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	indexEndpoint  = "/index"
-	searchEndpoint = "/search"
+	indexEndpoint      = "/index"
+	indexBatchEndpoint = "/index/batch"
+	deleteEndpoint     = "/delete"
+	searchEndpoint     = "/search"
 )
 
 var (
 	ErrInvalidBaseURL = errors.New("search client: invalid base url")
 	ErrUnexpectedCode = errors.New("search client: unexpected response code")
+	ErrMissingResult  = errors.New("search client: no result for task in batch")
 )
 
+// StatusError reports a non-2xx answer; it matches ErrUnexpectedCode.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %d", ErrUnexpectedCode, e.StatusCode)
+}
+
+func (e *StatusError) Is(target error) bool {
+	return target == ErrUnexpectedCode
+}
+
 type Client struct {
 	baseURL       *url.URL
 	httpClient    *http.Client
@@ -80,11 +99,21 @@ func New(cfg config.SearchConfig, httpClient *http.Client, opts ...Option) (*Cli
 }
 
 type IndexTask struct {
-	TaskName string `json:"task_name"`
-	TaskDesc string `json:"task_desc"`
-	GeoData  string `json:"geo_data,omitempty"`
-	TaskID   string `json:"task_id"`
-	TaskType string `json:"task_type,omitempty"`
+	TaskName string
+	TaskDesc string
+	GeoData  string
+	TaskID   string
+	TaskType string
+
+	// The structured attributes below back the SearchFilter fields.
+	CustomerID       string
+	VerificationType string
+	Cost             int
+	// MembersCount is 0 when the task takes any number of members.
+	MembersCount int
+	OpenSlots    int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 func (c *Client) IndexTask(ctx context.Context, task IndexTask) error {
@@ -92,19 +121,75 @@ func (c *Client) IndexTask(ctx context.Context, task IndexTask) error {
 		return errors.New("search client: task_id is required")
 	}
 
-	return c.doRequest(ctx, c.indexTimeout, indexEndpoint, task, nil)
+	return c.doRequest(ctx, c.indexTimeout, indexEndpoint, indexTaskToProto(task), nil)
+}
+
+// IndexResult is the outcome of one task of an IndexTasks batch.
+type IndexResult struct {
+	TaskID string
+	Err    error
+}
+
+// ItemError is a failure the search server reported for a single task of a
+// batch.
+type ItemError struct {
+	Message string
+}
+
+func (e *ItemError) Error() string {
+	return "search client: index item: " + e.Message
+}
+
+// IndexTasks indexes tasks in one request. The returned error covers the
+// request as a whole; per-task failures are reported in the results, which
+// follow the order of tasks.
+func (c *Client) IndexTasks(ctx context.Context, tasks []IndexTask) ([]IndexResult, error) {
+	if len(tasks) == 0 {
+		return []IndexResult{}, nil
+	}
+	for _, task := range tasks {
+		if task.TaskID == "" {
+			return nil, errors.New("search client: task_id is required")
+		}
+	}
+
+	response := new(searchpb.DSIndexBatchResult)
+	if err := c.doRequest(ctx, c.indexTimeout, indexBatchEndpoint, indexBatchToProto(tasks), response); err != nil {
+		return nil, err
+	}
+	return indexResultsFromProto(tasks, response), nil
+}
+
+// DeleteTask removes a task from the index. Deleting an unknown id succeeds.
+func (c *Client) DeleteTask(ctx context.Context, taskID string) error {
+	if taskID == "" {
+		return errors.New("search client: task_id is required")
+	}
+
+	return c.doRequest(ctx, c.indexTimeout, deleteEndpoint, &searchpb.DSDeleteTask{TaskId: taskID}, nil)
 }
 
 type SearchRequest struct {
-	UserQuery string   `json:"user_query"`
-	GeoData   string   `json:"geo_data,omitempty"`
-	QueryType string   `json:"query_type,omitempty"`
-	UserTags  []string `json:"user_tags,omitempty"`
+	UserQuery string
+	GeoData   string
+	QueryType string
+	UserTags  []string
+	// Offset and Limit page through the ranked results; the search server
+	// applies its configured search_offset/search_limit when they are zero.
+	Offset int
+	Limit  int
+	Filter SearchFilter
 }
 
 type SearchResponse struct {
-	TaskIDs []string `json:"task_id"`
-	Status  string   `json:"status"`
+	TaskIDs []string
+	Status  string
+	// Total is the number of matches across all pages; 0 when the backend
+	// does not report it.
+	Total int
+	// AppliedFilters names the filters of the request the backend applied;
+	// the caller has to apply the others.
+	AppliedFilters []string
 }
 
 func (c *Client) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
@@ -112,19 +197,23 @@ func (c *Client) Search(ctx context.Context, req SearchRequest) (*SearchResponse
 		return nil, errors.New("search client: user_query is required")
 	}
 
-	var response SearchResponse
-	if err := c.doRequest(ctx, c.searchTimeout, searchEndpoint, req, &response); err != nil {
+	response := new(searchpb.DSearchResult)
+	if err := c.doRequest(ctx, c.searchTimeout, searchEndpoint, searchRequestToProto(req), response); err != nil {
 		return nil, err
 	}
-	return &response, nil
+	return searchResponseFromProto(response), nil
 }
 
-func (c *Client) doRequest(ctx context.Context, timeout time.Duration, endpoint string, payload any, out any) error {
+// doRequest posts payload as JSON with the proto field names the search
+// server expects and decodes the answer into out, if given.
+func (c *Client) doRequest(ctx context.Context, timeout time.Duration, endpoint string, payload proto.Message, out proto.Message) error {
 	buf := &bytes.Buffer{}
 	if payload != nil {
-		if err := json.NewEncoder(buf).Encode(payload); err != nil {
+		data, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(payload)
+		if err != nil {
 			return fmt.Errorf("search client: encode payload: %w", err)
 		}
+		buf.Write(data)
 	}
 
 	reqURL := *c.baseURL
@@ -151,11 +240,15 @@ func (c *Client) doRequest(ctx context.Context, timeout time.Duration, endpoint
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		c.logger.Warn("search request failed", zap.Int("status_code", resp.StatusCode))
-		return fmt.Errorf("%w: %d", ErrUnexpectedCode, resp.StatusCode)
+		return &StatusError{StatusCode: resp.StatusCode}
 	}
 
 	if out != nil {
-		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("search client: read response: %w", err)
+		}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, out); err != nil {
 			return fmt.Errorf("search client: decode response: %w", err)
 		}
 	}
@@ -0,0 +1,234 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"DobrikaDev/task-service/internal/integration/search"
+	"DobrikaDev/task-service/internal/integration/search/searchtest"
+	"DobrikaDev/task-service/utils/config"
+)
+
+var transports = []string{search.TransportHTTP, search.TransportGRPC}
+
+// newEngines starts server on both transports and returns a client for each.
+func newEngines(t *testing.T, server *searchtest.Server) map[string]search.Engine {
+	t.Helper()
+
+	httpServer := server.StartHTTP()
+	t.Cleanup(httpServer.Close)
+
+	httpClient, err := search.New(config.SearchConfig{BaseURL: httpServer.URL}, httpServer.Client())
+	if err != nil {
+		t.Fatalf("create http client: %v", err)
+	}
+
+	conn, stop, err := server.StartGRPC()
+	if err != nil {
+		t.Fatalf("start grpc server: %v", err)
+	}
+	t.Cleanup(stop)
+
+	grpcClient, err := search.NewGRPC(config.SearchConfig{}, conn)
+	if err != nil {
+		t.Fatalf("create grpc client: %v", err)
+	}
+
+	return map[string]search.Engine{
+		search.TransportHTTP: httpClient,
+		search.TransportGRPC: grpcClient,
+	}
+}
+
+func indexedIDs(server *searchtest.Server) []string {
+	ids := make([]string, 0)
+	for _, task := range server.Tasks() {
+		ids = append(ids, task.GetTaskId())
+	}
+	return ids
+}
+
+func TestEngineIndexAndDelete(t *testing.T) {
+	for _, transport := range transports {
+		t.Run(transport, func(t *testing.T) {
+			server := searchtest.NewServer()
+			engine := newEngines(t, server)[transport]
+			ctx := context.Background()
+
+			task := search.IndexTask{
+				TaskID:     "task-1",
+				TaskName:   "Help at the shelter",
+				TaskDesc:   "Walk the dogs",
+				TaskType:   "animals",
+				CustomerID: "customer-1",
+				Cost:       30,
+			}
+			if err := engine.IndexTask(ctx, task); err != nil {
+				t.Fatalf("index: %v", err)
+			}
+
+			indexed := server.Tasks()
+			if len(indexed) != 1 {
+				t.Fatalf("indexed %d tasks, want 1", len(indexed))
+			}
+			if got := indexed[0]; got.GetTaskName() != task.TaskName || got.GetCustomerId() != task.CustomerID || got.GetCost() != 30 {
+				t.Fatalf("indexed task = %v, want the fields of %+v", got, task)
+			}
+
+			if err := engine.DeleteTask(ctx, "task-1"); err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+			if ids := indexedIDs(server); len(ids) != 0 {
+				t.Fatalf("tasks left after delete: %v", ids)
+			}
+
+			if err := engine.DeleteTask(ctx, "unknown"); err != nil {
+				t.Fatalf("delete unknown task: %v", err)
+			}
+		})
+	}
+}
+
+func TestEngineIndexTasks(t *testing.T) {
+	for _, transport := range transports {
+		t.Run(transport, func(t *testing.T) {
+			server := searchtest.NewServer()
+			engine := newEngines(t, server)[transport]
+
+			results, err := engine.IndexTasks(context.Background(), []search.IndexTask{
+				{TaskID: "b", TaskName: "clean the beach"},
+				{TaskID: "a", TaskName: "clean the park"},
+			})
+			if err != nil {
+				t.Fatalf("index batch: %v", err)
+			}
+
+			if len(results) != 2 || results[0].TaskID != "b" || results[1].TaskID != "a" {
+				t.Fatalf("results = %+v, want b and a in request order", results)
+			}
+			for _, result := range results {
+				if result.Err != nil {
+					t.Fatalf("task %s failed: %v", result.TaskID, result.Err)
+				}
+			}
+			if ids := indexedIDs(server); !slices.Equal(ids, []string{"a", "b"}) {
+				t.Fatalf("indexed %v, want [a b]", ids)
+			}
+
+			empty, err := engine.IndexTasks(context.Background(), nil)
+			if err != nil || len(empty) != 0 {
+				t.Fatalf("empty batch = %v, %v; want no results", empty, err)
+			}
+		})
+	}
+}
+
+func TestEngineSearchPaging(t *testing.T) {
+	for _, transport := range transports {
+		t.Run(transport, func(t *testing.T) {
+			server := searchtest.NewServer()
+			engine := newEngines(t, server)[transport]
+			ctx := context.Background()
+
+			tasks := []search.IndexTask{
+				{TaskID: "t1", TaskName: "Clean the park"},
+				{TaskID: "t2", TaskName: "Clean the beach"},
+				{TaskID: "t3", TaskName: "Clean the river", TaskDesc: "bring gloves"},
+				{TaskID: "t4", TaskName: "Paint the fence"},
+			}
+			if _, err := engine.IndexTasks(ctx, tasks); err != nil {
+				t.Fatalf("index batch: %v", err)
+			}
+
+			var pages [][]string
+			for offset := 0; offset < 4; offset += 2 {
+				resp, err := engine.Search(ctx, search.SearchRequest{UserQuery: "clean", Offset: offset, Limit: 2})
+				if err != nil {
+					t.Fatalf("search at offset %d: %v", offset, err)
+				}
+				if resp.Total != 3 {
+					t.Fatalf("total = %d, want 3", resp.Total)
+				}
+				pages = append(pages, resp.TaskIDs)
+			}
+
+			if !slices.Equal(pages[0], []string{"t1", "t2"}) || !slices.Equal(pages[1], []string{"t3"}) {
+				t.Fatalf("pages = %v, want [[t1 t2] [t3]]", pages)
+			}
+
+			resp, err := engine.Search(ctx, search.SearchRequest{UserQuery: "gloves"})
+			if err != nil {
+				t.Fatalf("search description: %v", err)
+			}
+			if !slices.Equal(resp.TaskIDs, []string{"t3"}) {
+				t.Fatalf("description search = %v, want [t3]", resp.TaskIDs)
+			}
+		})
+	}
+}
+
+func TestEngineSearchFilters(t *testing.T) {
+	for _, transport := range transports {
+		t.Run(transport, func(t *testing.T) {
+			server := searchtest.NewServer()
+			engine := newEngines(t, server)[transport]
+			ctx := context.Background()
+
+			if _, err := engine.IndexTasks(ctx, []search.IndexTask{
+				{TaskID: "cheap", TaskName: "clean", CustomerID: "c1", Cost: 10},
+				{TaskID: "dear", TaskName: "clean", CustomerID: "c2", Cost: 50},
+			}); err != nil {
+				t.Fatalf("index batch: %v", err)
+			}
+
+			costMin := 20
+			filter := search.SearchFilter{CostMin: &costMin}
+			resp, err := engine.Search(ctx, search.SearchRequest{UserQuery: "clean", Filter: filter})
+			if err != nil {
+				t.Fatalf("search: %v", err)
+			}
+			if !slices.Equal(resp.TaskIDs, []string{"dear"}) {
+				t.Fatalf("filtered search = %v, want [dear]", resp.TaskIDs)
+			}
+			if unapplied := filter.Unapplied(resp.AppliedFilters); len(unapplied) != 0 {
+				t.Fatalf("unapplied filters = %v, want none", unapplied)
+			}
+		})
+	}
+}
+
+func TestEngineRejectsMissingIDs(t *testing.T) {
+	for transport, engine := range newEngines(t, searchtest.NewServer()) {
+		t.Run(transport, func(t *testing.T) {
+			ctx := context.Background()
+			if err := engine.IndexTask(ctx, search.IndexTask{TaskName: "no id"}); err == nil {
+				t.Fatal("index without task id succeeded")
+			}
+			if _, err := engine.IndexTasks(ctx, []search.IndexTask{{TaskID: "a"}, {}}); err == nil {
+				t.Fatal("batch with a task without id succeeded")
+			}
+			if _, err := engine.Search(ctx, search.SearchRequest{}); err == nil {
+				t.Fatal("search without query succeeded")
+			}
+		})
+	}
+}
+
+func TestClientStatusError(t *testing.T) {
+	server := searchtest.NewServer()
+	httpServer := server.StartHTTP()
+	t.Cleanup(httpServer.Close)
+
+	client, err := search.New(config.SearchConfig{BaseURL: httpServer.URL + "/missing"}, httpServer.Client())
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+
+	_, err = client.Search(context.Background(), search.SearchRequest{UserQuery: "clean"})
+	var statusErr *search.StatusError
+	if !errors.As(err, &statusErr) || !errors.Is(err, search.ErrUnexpectedCode) {
+		t.Fatalf("err = %v, want a StatusError", err)
+	}
+}
@@ -0,0 +1,131 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	searchpb "DobrikaDev/task-service/internal/generated/proto/search"
+)
+
+const (
+	TransportHTTP = "http"
+	TransportGRPC = "grpc"
+)
+
+// Engine is a transport to the search server. Client speaks JSON over HTTP,
+// GRPCClient speaks protobuf over gRPC.
+type Engine interface {
+	IndexTask(ctx context.Context, task IndexTask) error
+	IndexTasks(ctx context.Context, tasks []IndexTask) ([]IndexResult, error)
+	DeleteTask(ctx context.Context, taskID string) error
+	Search(ctx context.Context, req SearchRequest) (*SearchResponse, error)
+}
+
+var (
+	_ Engine = (*Client)(nil)
+	_ Engine = (*GRPCClient)(nil)
+)
+
+func indexTaskToProto(task IndexTask) *searchpb.DSIndexTask {
+	return &searchpb.DSIndexTask{
+		TaskName: task.TaskName,
+		TaskDesc: task.TaskDesc,
+		GeoData:  task.GeoData,
+		TaskId:   task.TaskID,
+		TaskType: task.TaskType,
+
+		CustomerId:       task.CustomerID,
+		VerificationType: task.VerificationType,
+		Cost:             int32(task.Cost),
+		MembersCount:     int32(task.MembersCount),
+		OpenSlots:        int32(task.OpenSlots),
+		CreatedAt:        unixSeconds(task.CreatedAt),
+		UpdatedAt:        unixSeconds(task.UpdatedAt),
+	}
+}
+
+func indexBatchToProto(tasks []IndexTask) *searchpb.DSIndexBatch {
+	batch := &searchpb.DSIndexBatch{Tasks: make([]*searchpb.DSIndexTask, 0, len(tasks))}
+	for _, task := range tasks {
+		batch.Tasks = append(batch.Tasks, indexTaskToProto(task))
+	}
+	return batch
+}
+
+// indexResultsFromProto lines the per-item answers up with tasks. A task the
+// server did not answer for is reported as failed.
+func indexResultsFromProto(tasks []IndexTask, resp *searchpb.DSIndexBatchResult) []IndexResult {
+	byID := make(map[string]*searchpb.DSIndexItemResult, len(resp.GetResults()))
+	for _, item := range resp.GetResults() {
+		byID[item.GetTaskId()] = item
+	}
+
+	results := make([]IndexResult, 0, len(tasks))
+	for _, task := range tasks {
+		result := IndexResult{TaskID: task.TaskID}
+		item, ok := byID[task.TaskID]
+		switch {
+		case !ok:
+			result.Err = ErrMissingResult
+		case item.GetError() != "":
+			result.Err = &ItemError{Message: item.GetError()}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func searchRequestToProto(req SearchRequest) *searchpb.DSearchRequest {
+	return &searchpb.DSearchRequest{
+		UserQuery:    req.UserQuery,
+		GeoData:      req.GeoData,
+		UserTags:     req.UserTags,
+		QueryType:    req.QueryType,
+		SearchOffset: int32(req.Offset),
+		SearchLimit:  int32(req.Limit),
+		Filters:      searchFilterToProto(req.Filter),
+	}
+}
+
+func searchFilterToProto(filter SearchFilter) *searchpb.DSearchFilters {
+	if len(filter.Names()) == 0 {
+		return nil
+	}
+
+	pb := &searchpb.DSearchFilters{
+		CustomerIds:       filter.CustomerIDs,
+		VerificationTypes: filter.VerificationTypes,
+		MinOpenSlots:      int32(filter.MinOpenSlots),
+		CreatedFrom:       unixSeconds(filter.CreatedFrom),
+		CreatedTo:         unixSeconds(filter.CreatedTo),
+		UpdatedFrom:       unixSeconds(filter.UpdatedFrom),
+		UpdatedTo:         unixSeconds(filter.UpdatedTo),
+	}
+	if filter.CostMin != nil {
+		costMin := int32(*filter.CostMin)
+		pb.CostMin = &costMin
+	}
+	if filter.CostMax != nil {
+		costMax := int32(*filter.CostMax)
+		pb.CostMax = &costMax
+	}
+	return pb
+}
+
+func searchResponseFromProto(resp *searchpb.DSearchResult) *SearchResponse {
+	return &SearchResponse{
+		TaskIDs:        resp.GetTaskId(),
+		Status:         resp.GetStatus(),
+		Total:          int(resp.GetTotal()),
+		AppliedFilters: resp.GetAppliedFilters(),
+	}
+}
+
+// unixSeconds converts t to the unix seconds of the search protocol; the zero
+// time becomes 0.
+func unixSeconds(t time.Time) int32 {
+	if t.IsZero() {
+		return 0
+	}
+	return int32(t.Unix())
+}
@@ -0,0 +1,39 @@
+package search
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type Searcher interface {
+	Search(ctx context.Context, req SearchRequest) (*SearchResponse, error)
+}
+
+// Fallback sends searches to primary and retries them on secondary when
+// primary fails.
+type Fallback struct {
+	primary   Searcher
+	secondary Searcher
+	logger    *zap.Logger
+}
+
+func NewFallback(primary, secondary Searcher, logger *zap.Logger) *Fallback {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Fallback{primary: primary, secondary: secondary, logger: logger}
+}
+
+func (f *Fallback) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	resp, err := f.primary.Search(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+
+	f.logger.Warn("primary search failed, using fallback", zap.Error(err))
+	return f.secondary.Search(ctx, req)
+}
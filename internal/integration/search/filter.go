@@ -0,0 +1,104 @@
+package search
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the SearchFilter fields, as reported in
+// SearchResponse.AppliedFilters.
+const (
+	FilterCustomer         = "customer_id"
+	FilterVerificationType = "verification_type"
+	FilterCost             = "cost"
+	FilterOpenSlots        = "open_slots"
+	FilterCreatedAt        = "created_at"
+	FilterUpdatedAt        = "updated_at"
+)
+
+// SearchFilter narrows a search by the structured attributes of IndexTask.
+// Zero fields do not filter; time ranges are half-open [from, to).
+type SearchFilter struct {
+	CustomerIDs       []string
+	VerificationTypes []string
+	CostMin           *int
+	CostMax           *int
+	// MinOpenSlots keeps tasks with at least this many open slots; tasks
+	// without a member limit always match.
+	MinOpenSlots int
+	CreatedFrom  time.Time
+	CreatedTo    time.Time
+	UpdatedFrom  time.Time
+	UpdatedTo    time.Time
+}
+
+// Names returns the names of the filters that are set.
+func (f SearchFilter) Names() []string {
+	names := make([]string, 0, 6)
+	if len(f.CustomerIDs) > 0 {
+		names = append(names, FilterCustomer)
+	}
+	if len(f.VerificationTypes) > 0 {
+		names = append(names, FilterVerificationType)
+	}
+	if f.CostMin != nil || f.CostMax != nil {
+		names = append(names, FilterCost)
+	}
+	if f.MinOpenSlots > 0 {
+		names = append(names, FilterOpenSlots)
+	}
+	if !f.CreatedFrom.IsZero() || !f.CreatedTo.IsZero() {
+		names = append(names, FilterCreatedAt)
+	}
+	if !f.UpdatedFrom.IsZero() || !f.UpdatedTo.IsZero() {
+		names = append(names, FilterUpdatedAt)
+	}
+	return names
+}
+
+// Unapplied returns the names of the filters that are set but missing from
+// applied.
+func (f SearchFilter) Unapplied(applied []string) []string {
+	names := f.Names()
+	return slices.DeleteFunc(names, func(name string) bool {
+		return slices.Contains(applied, name)
+	})
+}
+
+// Key renders the filter for cache keys and page token fingerprints; value
+// lists are sorted so that their order does not matter.
+func (f SearchFilter) Key() string {
+	customers := slices.Clone(f.CustomerIDs)
+	slices.Sort(customers)
+	types := slices.Clone(f.VerificationTypes)
+	slices.Sort(types)
+
+	parts := []string{
+		strings.Join(customers, ","),
+		strings.Join(types, ","),
+		optionalInt(f.CostMin),
+		optionalInt(f.CostMax),
+		strconv.Itoa(f.MinOpenSlots),
+		unixOrEmpty(f.CreatedFrom),
+		unixOrEmpty(f.CreatedTo),
+		unixOrEmpty(f.UpdatedFrom),
+		unixOrEmpty(f.UpdatedTo),
+	}
+	return strings.Join(parts, "|")
+}
+
+func optionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+func unixOrEmpty(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,95 @@
+package search
+
+import (
+	"context"
+	"errors"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/internal/storage/sql"
+	"DobrikaDev/task-service/utils/config"
+)
+
+const (
+	FullTextModePrimary  = "primary"
+	FullTextModeFallback = "fallback"
+	FullTextModeDisabled = "disabled"
+
+	defaultFullTextLimit = 100
+)
+
+type fullTextStorage interface {
+	SearchTaskIDsFullText(
+		ctx context.Context,
+		text string,
+		tags []string,
+		language string,
+		limit, offset int,
+		opts ...sql.GetTasksOption,
+	) ([]string, int, error)
+}
+
+// FullText answers searches from Postgres full-text search over task names
+// and descriptions. Tags widen the query as alternatives and every filter is
+// applied in the query; geo data and query type are not supported and are
+// ignored. Closed tasks are left out, as they are from the index.
+type FullText struct {
+	storage  fullTextStorage
+	language string
+	limit    int
+}
+
+func NewFullText(storage fullTextStorage, cfg config.SearchConfig) *FullText {
+	limit := cfg.FullTextLimit
+	if limit <= 0 {
+		limit = defaultFullTextLimit
+	}
+
+	return &FullText{
+		storage:  storage,
+		language: cfg.FullTextLanguage,
+		limit:    limit,
+	}
+}
+
+func (f *FullText) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	if req.UserQuery == "" {
+		return nil, errors.New("search full-text: user_query is required")
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > f.limit {
+		limit = f.limit
+	}
+
+	ids, total, err := f.storage.SearchTaskIDsFullText(
+		ctx,
+		req.UserQuery,
+		req.UserTags,
+		f.language,
+		limit,
+		req.Offset,
+		filterOptions(req.Filter)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchResponse{TaskIDs: ids, Status: "ok", Total: total, AppliedFilters: req.Filter.Names()}, nil
+}
+
+// filterOptions translates filter into task query options.
+func filterOptions(filter SearchFilter) []sql.GetTasksOption {
+	verificationTypes := make([]domain.VerificationType, 0, len(filter.VerificationTypes))
+	for _, verificationType := range filter.VerificationTypes {
+		verificationTypes = append(verificationTypes, domain.VerificationType(verificationType))
+	}
+
+	return []sql.GetTasksOption{
+		sql.WithTaskCustomerIDs(filter.CustomerIDs),
+		sql.WithTaskVerificationTypes(verificationTypes),
+		sql.WithTaskCostRange(filter.CostMin, filter.CostMax),
+		sql.WithTaskMinOpenSlots(filter.MinOpenSlots),
+		sql.WithTaskCreatedBetween(filter.CreatedFrom, filter.CreatedTo),
+		sql.WithTaskUpdatedBetween(filter.UpdatedFrom, filter.UpdatedTo),
+	}
+}
@@ -0,0 +1,129 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	searchpb "DobrikaDev/task-service/internal/generated/proto/search"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type GRPCClient struct {
+	client        searchpb.DobrikaSearchClient
+	indexTimeout  time.Duration
+	searchTimeout time.Duration
+	logger        *zap.Logger
+}
+
+type GRPCOption func(*GRPCClient)
+
+func WithGRPCLogger(logger *zap.Logger) GRPCOption {
+	return func(c *GRPCClient) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// NewGRPC creates a client over conn. The caller owns conn and closes it.
+func NewGRPC(cfg config.SearchConfig, conn grpc.ClientConnInterface, opts ...GRPCOption) (*GRPCClient, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("search client: grpc connection is required")
+	}
+
+	client := &GRPCClient{
+		client:        searchpb.NewDobrikaSearchClient(conn),
+		indexTimeout:  cfg.IndexTimeout,
+		searchTimeout: cfg.SearchTimeout,
+		logger:        zap.NewNop(),
+	}
+
+	if client.indexTimeout <= 0 {
+		client.indexTimeout = 3 * time.Second
+	}
+	if client.searchTimeout <= 0 {
+		client.searchTimeout = 2 * time.Second
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client, nil
+}
+
+func (c *GRPCClient) IndexTask(ctx context.Context, task IndexTask) error {
+	if task.TaskID == "" {
+		return errors.New("search client: task_id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.indexTimeout)
+	defer cancel()
+
+	if _, err := c.client.Index(ctx, indexTaskToProto(task)); err != nil {
+		c.logger.Warn("search index request failed", zap.Error(err))
+		return fmt.Errorf("search client: index: %w", err)
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) IndexTasks(ctx context.Context, tasks []IndexTask) ([]IndexResult, error) {
+	if len(tasks) == 0 {
+		return []IndexResult{}, nil
+	}
+	for _, task := range tasks {
+		if task.TaskID == "" {
+			return nil, errors.New("search client: task_id is required")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.indexTimeout)
+	defer cancel()
+
+	resp, err := c.client.IndexBatch(ctx, indexBatchToProto(tasks))
+	if err != nil {
+		c.logger.Warn("search index batch request failed", zap.Error(err))
+		return nil, fmt.Errorf("search client: index batch: %w", err)
+	}
+
+	return indexResultsFromProto(tasks, resp), nil
+}
+
+func (c *GRPCClient) DeleteTask(ctx context.Context, taskID string) error {
+	if taskID == "" {
+		return errors.New("search client: task_id is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.indexTimeout)
+	defer cancel()
+
+	if _, err := c.client.Delete(ctx, &searchpb.DSDeleteTask{TaskId: taskID}); err != nil {
+		c.logger.Warn("search delete request failed", zap.Error(err))
+		return fmt.Errorf("search client: delete: %w", err)
+	}
+
+	return nil
+}
+
+func (c *GRPCClient) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	if req.UserQuery == "" {
+		return nil, errors.New("search client: user_query is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.searchTimeout)
+	defer cancel()
+
+	resp, err := c.client.Search(ctx, searchRequestToProto(req))
+	if err != nil {
+		c.logger.Warn("search request failed", zap.Error(err))
+		return nil, fmt.Errorf("search client: search: %w", err)
+	}
+
+	return searchResponseFromProto(resp), nil
+}
@@ -0,0 +1,209 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRetryAttempts    = 3
+	defaultRetryBaseDelay   = 100 * time.Millisecond
+	defaultRetryMaxDelay    = 2 * time.Second
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// Resilient wraps an Engine with retries and a circuit breaker. Every search
+// server call is idempotent (index and delete are keyed by task id), so all
+// of them are retried on transient errors: transport failures, 429 and 5xx
+// answers, and the matching gRPC codes.
+type Resilient struct {
+	engine  Engine
+	breaker *breaker
+	logger  *zap.Logger
+
+	attempts  int
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+func NewResilient(engine Engine, cfg config.SearchConfig, logger *zap.Logger) *Resilient {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	attempts := cfg.RetryMaxAttempts
+	if attempts <= 0 {
+		attempts = defaultRetryAttempts
+	}
+	baseDelay := cfg.RetryBaseDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultRetryBaseDelay
+	}
+	maxDelay := cfg.RetryMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+	threshold := cfg.BreakerFailureThreshold
+	if threshold <= 0 {
+		threshold = defaultBreakerThreshold
+	}
+	cooldown := cfg.BreakerCooldown
+	if cooldown <= 0 {
+		cooldown = defaultBreakerCooldown
+	}
+
+	return &Resilient{
+		engine:    engine,
+		breaker:   newBreaker(threshold, cooldown),
+		logger:    logger,
+		attempts:  attempts,
+		baseDelay: baseDelay,
+		maxDelay:  maxDelay,
+	}
+}
+
+// BreakerState reports the circuit breaker state for health checks.
+func (r *Resilient) BreakerState() BreakerState {
+	return r.breaker.State()
+}
+
+func (r *Resilient) IndexTask(ctx context.Context, task IndexTask) error {
+	return r.do(ctx, "index", func(ctx context.Context) error {
+		return r.engine.IndexTask(ctx, task)
+	})
+}
+
+// IndexTasks retries the batch as a whole; per-task failures in the results
+// are left to the caller.
+func (r *Resilient) IndexTasks(ctx context.Context, tasks []IndexTask) ([]IndexResult, error) {
+	var results []IndexResult
+	err := r.do(ctx, "index_batch", func(ctx context.Context) error {
+		var err error
+		results, err = r.engine.IndexTasks(ctx, tasks)
+		return err
+	})
+	return results, err
+}
+
+func (r *Resilient) DeleteTask(ctx context.Context, taskID string) error {
+	return r.do(ctx, "delete", func(ctx context.Context) error {
+		return r.engine.DeleteTask(ctx, taskID)
+	})
+}
+
+func (r *Resilient) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	var resp *SearchResponse
+	err := r.do(ctx, "search", func(ctx context.Context) error {
+		var err error
+		resp, err = r.engine.Search(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+func (r *Resilient) do(ctx context.Context, operation string, call func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < r.attempts; attempt++ {
+		if attempt > 0 {
+			if waitErr := sleep(ctx, r.backoff(attempt)); waitErr != nil {
+				return err
+			}
+		}
+
+		if !r.breaker.allow() {
+			return ErrCircuitOpen
+		}
+
+		err = call(ctx)
+		if err != nil && ctx.Err() != nil {
+			// The caller gave up; the call says nothing about the backend.
+			r.breaker.release()
+			return err
+		}
+		retryable := err != nil && isRetryable(err)
+
+		// Caller mistakes such as 4xx answers say nothing about the
+		// backend's health and do not count against the breaker.
+		previous, current := r.breaker.record(err == nil || !retryable)
+		if previous != current {
+			r.logger.Warn(
+				"search circuit breaker changed state",
+				zap.String("from", previous.String()),
+				zap.String("to", current.String()),
+			)
+		}
+
+		if !retryable {
+			return err
+		}
+
+		r.logger.Warn(
+			"search call failed, retrying",
+			zap.Error(err),
+			zap.String("operation", operation),
+			zap.Int("attempt", attempt+1),
+		)
+	}
+	return err
+}
+
+// backoff returns a full-jitter delay for the given retry.
+func (r *Resilient) backoff(attempt int) time.Duration {
+	delay := r.baseDelay << (attempt - 1)
+	if delay <= 0 || delay > r.maxDelay {
+		delay = r.maxDelay
+	}
+	return time.Duration(rand.Int64N(int64(delay)) + 1)
+}
+
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// Transport failures of the HTTP client: refused connections, resets.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		switch grpcStatus.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		}
+	}
+
+	return false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+// blockingEngine fails every call with a server error, or waits for the
+// caller to give up when block is set.
+type blockingEngine struct {
+	Engine
+	block bool
+}
+
+func (e *blockingEngine) DeleteTask(ctx context.Context, _ string) error {
+	if e.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return &StatusError{StatusCode: 503}
+}
+
+func newTestResilient(engine Engine) *Resilient {
+	return NewResilient(engine, config.SearchConfig{
+		RetryMaxAttempts:        1,
+		BreakerFailureThreshold: 1,
+		BreakerCooldown:         time.Nanosecond,
+	}, zap.NewNop())
+}
+
+func TestResilientIgnoresCancelledCalls(t *testing.T) {
+	engine := &blockingEngine{}
+	resilient := newTestResilient(engine)
+
+	if err := resilient.DeleteTask(context.Background(), "task-1"); err == nil {
+		t.Fatal("failing call succeeded")
+	}
+	if state := resilient.breaker.state; state != BreakerOpen {
+		t.Fatalf("state after a server error = %s, want open", state)
+	}
+
+	// The cooldown is over, so the next call is the half-open probe. The
+	// caller cancels it: the breaker must neither close nor count a failure,
+	// and must let the next probe through.
+	engine.block = true
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if err := resilient.DeleteTask(ctx, "task-1"); err == nil {
+		t.Fatal("cancelled call succeeded")
+	}
+	if state := resilient.breaker.state; state != BreakerHalfOpen {
+		t.Fatalf("state after a cancelled probe = %s, want half_open", state)
+	}
+	if !resilient.breaker.allow() {
+		t.Fatal("the cancelled probe was not released")
+	}
+}
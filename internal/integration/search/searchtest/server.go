@@ -0,0 +1,231 @@
+// Package searchtest provides an in-process fake of the search server that
+// speaks both transports, for local runs and client tests.
+package searchtest
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	searchpb "DobrikaDev/task-service/internal/generated/proto/search"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Server keeps indexed tasks in memory and matches a query when every word
+// of it occurs in the task name or description. It applies every search
+// filter and reports them all as applied.
+type Server struct {
+	searchpb.UnimplementedDobrikaSearchServer
+
+	mu    sync.Mutex
+	tasks map[string]*searchpb.DSIndexTask
+}
+
+func NewServer() *Server {
+	return &Server{tasks: make(map[string]*searchpb.DSIndexTask)}
+}
+
+// Tasks returns the indexed tasks ordered by id.
+func (s *Server) Tasks() []*searchpb.DSIndexTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*searchpb.DSIndexTask, 0, len(s.tasks))
+	for _, task := range s.tasks {
+		result = append(result, proto.Clone(task).(*searchpb.DSIndexTask))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GetTaskId() < result[j].GetTaskId() })
+	return result
+}
+
+func (s *Server) Index(_ context.Context, req *searchpb.DSIndexTask) (*searchpb.DSIndexResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[req.GetTaskId()] = proto.Clone(req).(*searchpb.DSIndexTask)
+	return &searchpb.DSIndexResult{Status: "ok"}, nil
+}
+
+func (s *Server) IndexBatch(_ context.Context, req *searchpb.DSIndexBatch) (*searchpb.DSIndexBatchResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &searchpb.DSIndexBatchResult{}
+	for _, task := range req.GetTasks() {
+		item := &searchpb.DSIndexItemResult{TaskId: task.GetTaskId(), Status: "ok"}
+		if task.GetTaskId() == "" {
+			item.Status = "error"
+			item.Error = "task_id is required"
+		} else {
+			s.tasks[task.GetTaskId()] = proto.Clone(task).(*searchpb.DSIndexTask)
+		}
+		result.Results = append(result.Results, item)
+	}
+	return result, nil
+}
+
+func (s *Server) Delete(_ context.Context, req *searchpb.DSDeleteTask) (*searchpb.DSIndexResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tasks, req.GetTaskId())
+	return &searchpb.DSIndexResult{Status: "ok"}, nil
+}
+
+func (s *Server) Search(_ context.Context, req *searchpb.DSearchRequest) (*searchpb.DSearchResult, error) {
+	words := strings.Fields(strings.ToLower(req.GetUserQuery()))
+
+	s.mu.Lock()
+	ids := make([]string, 0)
+	for id, task := range s.tasks {
+		text := strings.ToLower(task.GetTaskName() + " " + task.GetTaskDesc())
+		matched := true
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				matched = false
+				break
+			}
+		}
+		if matched && matchesFilters(task, req.GetFilters()) {
+			ids = append(ids, id)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Strings(ids)
+	total := len(ids)
+
+	offset := int(req.GetSearchOffset())
+	if offset > len(ids) {
+		offset = len(ids)
+	}
+	ids = ids[offset:]
+	if limit := int(req.GetSearchLimit()); limit > 0 && limit < len(ids) {
+		ids = ids[:limit]
+	}
+
+	return &searchpb.DSearchResult{
+		TaskId:         ids,
+		Status:         "ok",
+		Total:          int32(total),
+		AppliedFilters: appliedFilters,
+	}, nil
+}
+
+var appliedFilters = []string{"customer_id", "verification_type", "cost", "open_slots", "created_at", "updated_at"}
+
+func matchesFilters(task *searchpb.DSIndexTask, filters *searchpb.DSearchFilters) bool {
+	if filters == nil {
+		return true
+	}
+	if len(filters.GetCustomerIds()) > 0 && !slices.Contains(filters.GetCustomerIds(), task.GetCustomerId()) {
+		return false
+	}
+	if len(filters.GetVerificationTypes()) > 0 && !slices.Contains(filters.GetVerificationTypes(), task.GetVerificationType()) {
+		return false
+	}
+	if filters.CostMin != nil && task.GetCost() < filters.GetCostMin() {
+		return false
+	}
+	if filters.CostMax != nil && task.GetCost() > filters.GetCostMax() {
+		return false
+	}
+	if task.GetMembersCount() > 0 && task.GetOpenSlots() < filters.GetMinOpenSlots() {
+		return false
+	}
+	return inRange(task.GetCreatedAt(), filters.GetCreatedFrom(), filters.GetCreatedTo()) &&
+		inRange(task.GetUpdatedAt(), filters.GetUpdatedFrom(), filters.GetUpdatedTo())
+}
+
+// inRange reports whether value is in [from, to); 0 leaves a side open.
+func inRange(value, from, to int32) bool {
+	return (from == 0 || value >= from) && (to == 0 || value < to)
+}
+
+// Handler serves the JSON endpoints of the HTTP transport.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /index", jsonHandler(s.Index))
+	mux.HandleFunc("POST /index/batch", jsonHandler(s.IndexBatch))
+	mux.HandleFunc("POST /delete", jsonHandler(s.Delete))
+	mux.HandleFunc("POST /search", jsonHandler(s.Search))
+	return mux
+}
+
+// StartHTTP serves the HTTP transport on a local port; close the returned
+// server when done and use its URL as the search base URL.
+func (s *Server) StartHTTP() *httptest.Server {
+	return httptest.NewServer(s.Handler())
+}
+
+// StartGRPC serves the gRPC transport over an in-memory listener and returns
+// a connection to it. The returned func stops the server and the connection.
+func (s *Server) StartGRPC() (*grpc.ClientConn, func(), error) {
+	listener := bufconn.Listen(1 << 20)
+
+	grpcServer := grpc.NewServer()
+	searchpb.RegisterDobrikaSearchServer(grpcServer, s)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, nil, err
+	}
+
+	return conn, func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	}, nil
+}
+
+func jsonHandler[Req any, Resp proto.Message, PReq interface {
+	*Req
+	proto.Message
+}](handle func(context.Context, PReq) (Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		req := PReq(new(Req))
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := handle(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrInvalidURL       = errors.New("webhook: url must be absolute http or https")
+	ErrForbiddenAddress = errors.New("webhook: target address is not public")
+)
+
+// cgnatPrefix is the shared address space of RFC 6598, which net/netip does
+// not classify as private.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddr reports whether addr may be the target of a webhook: loopback,
+// link-local, private, shared, multicast and unspecified addresses are
+// rejected so that subscriptions cannot reach the service's own network.
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!cgnatPrefix.Contains(addr)
+}
+
+// ValidateURL checks a subscription url when it is saved. Literal addresses
+// and localhost names must be public; names that resolve to a non-public
+// address are refused when dialing instead, see NewHTTPClient.
+func ValidateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenAddress
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !PublicAddr(addr) {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
+
+// NewHTTPClient returns the client webhooks are sent with. Its dialer refuses
+// every non-public address after name resolution, which also covers
+// redirects and DNS names pointing into the internal network. Proxies from
+// the environment are not used, since the dialer would only see the proxy.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			if !PublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,64 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/integration/webhook"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want error
+	}{
+		{"https://hooks.example.com/dobrika", nil},
+		{"http://203.0.113.10:8080/hook", nil},
+		{"ftp://hooks.example.com", webhook.ErrInvalidURL},
+		{"/relative", webhook.ErrInvalidURL},
+		{"http://localhost:8080", webhook.ErrForbiddenAddress},
+		{"http://api.localhost.", webhook.ErrForbiddenAddress},
+		{"http://127.0.0.1/hook", webhook.ErrForbiddenAddress},
+		{"http://10.1.2.3/hook", webhook.ErrForbiddenAddress},
+		{"http://192.168.0.1/hook", webhook.ErrForbiddenAddress},
+		{"http://169.254.169.254/latest/meta-data", webhook.ErrForbiddenAddress},
+		{"http://100.64.0.1/hook", webhook.ErrForbiddenAddress},
+		{"http://0.0.0.0/hook", webhook.ErrForbiddenAddress},
+		{"http://[::1]/hook", webhook.ErrForbiddenAddress},
+		{"http://[::ffff:127.0.0.1]/hook", webhook.ErrForbiddenAddress},
+		{"http://[fd00::1]/hook", webhook.ErrForbiddenAddress},
+	}
+
+	for _, tt := range tests {
+		if err := webhook.ValidateURL(tt.url); !errors.Is(err, tt.want) {
+			t.Errorf("ValidateURL(%q) = %v, want %v", tt.url, err, tt.want)
+		}
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	if !webhook.PublicAddr(netip.MustParseAddr("8.8.8.8")) {
+		t.Error("8.8.8.8 is reported as not public")
+	}
+	if webhook.PublicAddr(netip.MustParseAddr("fe80::1")) {
+		t.Error("fe80::1 is reported as public")
+	}
+}
+
+func TestHTTPClientRefusesLoopback(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("the guarded client reached a loopback receiver")
+	}))
+	t.Cleanup(receiver.Close)
+
+	sender := webhook.NewSender(webhook.NewHTTPClient(time.Second), time.Second)
+	_, err := sender.Send(context.Background(), webhook.Request{URL: receiver.URL, EventID: "event-1"})
+	if !errors.Is(err, webhook.ErrForbiddenAddress) {
+		t.Fatalf("err = %v, want ErrForbiddenAddress", err)
+	}
+}
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Dobrika-Signature"
+	EventIDHeader   = "X-Dobrika-Event-Id"
+	EventTypeHeader = "X-Dobrika-Event-Type"
+)
+
+var ErrUnexpectedCode = errors.New("webhook sender: unexpected response code")
+
+// Sign returns the value of the signature header for body sent at timestamp:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">". Receivers recompute
+// the HMAC with their secret and reject stale timestamps to prevent replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type Request struct {
+	URL       string
+	Secret    string
+	EventID   string
+	EventType string
+	Body      []byte
+}
+
+type Sender struct {
+	httpClient *http.Client
+	timeout    time.Duration
+	now        func() time.Time
+}
+
+func NewSender(httpClient *http.Client, timeout time.Duration) *Sender {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &Sender{httpClient: httpClient, timeout: timeout, now: time.Now}
+}
+
+// Send posts one signed webhook and returns the response status code. Any
+// non-2xx answer is reported as ErrUnexpectedCode.
+func (s *Sender) Send(ctx context.Context, req Request) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("webhook sender: create request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "dobrika-task-service-webhooks")
+	request.Header.Set(EventIDHeader, req.EventID)
+	request.Header.Set(EventTypeHeader, req.EventType)
+	request.Header.Set(SignatureHeader, Sign(req.Secret, s.now(), req.Body))
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("webhook sender: do request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%w: %d", ErrUnexpectedCode, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,80 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/integration/webhook"
+)
+
+func TestSenderSignsRequest(t *testing.T) {
+	body := []byte(`{"task_id":"task-1"}`)
+
+	var received *http.Request
+	var receivedBody []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(receiver.Close)
+
+	sender := webhook.NewSender(receiver.Client(), time.Second)
+	code, err := sender.Send(context.Background(), webhook.Request{
+		URL:       receiver.URL,
+		Secret:    "secret",
+		EventID:   "event-1",
+		EventType: "participant.joined",
+		Body:      body,
+	})
+	if err != nil || code != http.StatusNoContent {
+		t.Fatalf("send = %d, %v; want 204", code, err)
+	}
+
+	if got := received.Header.Get(webhook.EventIDHeader); got != "event-1" {
+		t.Errorf("event id header = %q", got)
+	}
+	if got := received.Header.Get(webhook.EventTypeHeader); got != "participant.joined" {
+		t.Errorf("event type header = %q", got)
+	}
+	if string(receivedBody) != string(body) {
+		t.Errorf("body = %s, want %s", receivedBody, body)
+	}
+
+	// The receiver recomputes the signature from the timestamp it was given.
+	signature := received.Header.Get(webhook.SignatureHeader)
+	ts, _, ok := strings.Cut(strings.TrimPrefix(signature, "t="), ",")
+	if !ok {
+		t.Fatalf("signature header = %q", signature)
+	}
+	seconds, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		t.Fatalf("signature timestamp %q: %v", ts, err)
+	}
+	if want := webhook.Sign("secret", time.Unix(seconds, 0), body); signature != want {
+		t.Errorf("signature = %q, want %q", signature, want)
+	}
+	if other := webhook.Sign("other", time.Unix(seconds, 0), body); signature == other {
+		t.Error("signature does not depend on the secret")
+	}
+}
+
+func TestSenderReportsStatus(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(receiver.Close)
+
+	sender := webhook.NewSender(receiver.Client(), time.Second)
+	code, err := sender.Send(context.Background(), webhook.Request{URL: receiver.URL})
+	if code != http.StatusBadGateway || !errors.Is(err, webhook.ErrUnexpectedCode) {
+		t.Fatalf("send = %d, %v; want 502 and ErrUnexpectedCode", code, err)
+	}
+}
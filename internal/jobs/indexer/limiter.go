@@ -0,0 +1,54 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter spaces calls to the search engine evenly at rate per second. A zero
+// or negative rate disables it.
+type limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until n more calls are allowed or ctx is done. A batch of n
+// items is charged as n calls.
+func (l *limiter) wait(ctx context.Context, n int) error {
+	if l.interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval * time.Duration(n))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package indexer
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,48 +16,121 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	defaultInterval          = 30 * time.Second
+	defaultBatchSize         = 200
+	defaultMaxRetries        = 3
+	defaultMaxBackoff        = 10 * time.Minute
+	baseBackoff              = 5 * time.Second
+	defaultReconcileInterval = 10 * time.Minute
+	defaultIndexBatchSize    = 50
+	defaultIndexWorkers      = 4
+	// jobClaimTTL is how long claimed jobs are hidden from other drains.
+	jobClaimTTL = 10 * time.Minute
+)
+
 type Storage interface {
-	GetTasksUpdatedAfter(ctx context.Context, after time.Time, limit int) ([]*domain.Task, error)
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	GetTasksUpdatedAfter(ctx context.Context, after domain.SearchCursor, limit int) ([]*domain.Task, error)
 	GetTasksByIDs(ctx context.Context, ids []string) ([]*domain.Task, error)
-	LoadSearchCursor(ctx context.Context) (time.Time, error)
-	SaveSearchCursor(ctx context.Context, cursor time.Time) error
+	LoadSearchCursor(ctx context.Context) (domain.SearchCursor, error)
+	SaveSearchCursor(ctx context.Context, cursor domain.SearchCursor) error
+	RecordIndexerRun(ctx context.Context, cause string) error
+	MarkTaskIndexed(ctx context.Context, taskID string) error
+	MarkTaskUnindexed(ctx context.Context, taskID string) error
+	GetOrphanedIndexEntries(ctx context.Context, limit int) ([]string, error)
+	EnqueueIndexJobs(ctx context.Context, taskIDs []string) error
+	LockDueIndexJobs(ctx context.Context, limit int) ([]*domain.IndexJob, error)
+	ClaimIndexJobs(ctx context.Context, ids []int64, until time.Time) error
+	CompleteIndexJobs(ctx context.Context, ids []int64) error
+	RecordIndexJobFailure(
+		ctx context.Context,
+		id int64,
+		status domain.IndexJobStatus,
+		cause string,
+		nextAttemptAt time.Time,
+	) error
 }
 
 type searchClient interface {
-	IndexTask(ctx context.Context, task searchintegration.IndexTask) error
+	IndexTasks(ctx context.Context, tasks []searchintegration.IndexTask) ([]searchintegration.IndexResult, error)
+	DeleteTask(ctx context.Context, taskID string) error
+}
+
+// cacheInvalidator drops cached search results that mention a task.
+type cacheInvalidator interface {
+	InvalidateTasks(taskIDs ...string)
+}
+
+// leadership tells whether this replica is the one that may run the indexer.
+type leadership interface {
+	IsLeader() bool
 }
 
+// Scheduler keeps the search engine in line with the tasks table. Its main
+// input is the search_index_jobs queue, which the task service fills in the
+// transaction of every change; failed jobs are retried with exponential
+// backoff and parked as dead after SchedulerMaxRetries retries. A sweep over
+// tasks updated after the saved cursor and a periodic reconcile catch
+// whatever did not go through the queue. With WithLeadership only the
+// elected replica does any of this.
 type Scheduler struct {
 	storage Storage
 	client  searchClient
+	cache   cacheInvalidator
+	leader  leadership
+	limiter *limiter
 	cfg     config.SearchConfig
 	logger  *zap.Logger
 
-	updates   chan string
+	wake      chan struct{}
+	runErr    error
 	startOnce sync.Once
 	stopOnce  sync.Once
 
 	ctx    context.Context
 	cancel context.CancelFunc
+	done   chan struct{}
 }
 
-func NewScheduler(storage Storage, client searchClient, cfg config.SearchConfig, logger *zap.Logger) *Scheduler {
-	if logger == nil {
-		logger = zap.NewNop()
+type Option func(*Scheduler)
+
+// WithCacheInvalidator makes the scheduler drop cached results for every task
+// it pushes to or removes from the search engine.
+func WithCacheInvalidator(cache cacheInvalidator) Option {
+	return func(s *Scheduler) {
+		s.cache = cache
 	}
+}
 
-	buffer := cfg.SchedulerBatchSize
-	if buffer <= 0 {
-		buffer = 200
+// WithLeadership makes the scheduler idle while leader reports that another
+// replica holds the indexer role.
+func WithLeadership(leader leadership) Option {
+	return func(s *Scheduler) {
+		s.leader = leader
 	}
+}
 
-	return &Scheduler{
+func NewScheduler(storage Storage, client searchClient, cfg config.SearchConfig, logger *zap.Logger, opts ...Option) *Scheduler {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	scheduler := &Scheduler{
 		storage: storage,
 		client:  client,
+		limiter: newLimiter(cfg.IndexRateLimit),
 		cfg:     cfg,
 		logger:  logger,
-		updates: make(chan string, buffer*2),
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(scheduler)
 	}
+
+	return scheduler
 }
 
 func (s *Scheduler) Start(parent context.Context) {
@@ -77,186 +151,507 @@ func (s *Scheduler) Start(parent context.Context) {
 
 func (s *Scheduler) Stop() {
 	s.stopOnce.Do(func() {
-		if s.cancel != nil {
-			s.cancel()
+		if s.cancel == nil {
+			return
 		}
+		s.cancel()
+		<-s.done
 	})
 }
 
+// NotifyTaskChanged wakes the scheduler to drain the job queue without
+// waiting for the next tick. The job itself must already be committed; on a
+// follower replica the leader picks it up on its next tick.
 func (s *Scheduler) NotifyTaskChanged(taskID string) {
 	if taskID == "" || s.client == nil {
 		return
 	}
 
 	select {
-	case s.updates <- taskID:
+	case s.wake <- struct{}{}:
 	default:
-		s.logger.Warn("search scheduler queue is full, dropping task id", zap.String("task_id", taskID))
 	}
 }
 
 func (s *Scheduler) loop() {
+	defer close(s.done)
+
 	interval := s.cfg.SchedulerInterval
 	if interval <= 0 {
-		interval = 30 * time.Second
+		interval = defaultInterval
+	}
+
+	reconcileInterval := s.cfg.ReconcileInterval
+	if reconcileInterval <= 0 {
+		reconcileInterval = defaultReconcileInterval
 	}
 
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	pending := make(map[string]int)
+	reconcileTicker := time.NewTicker(reconcileInterval)
+	defer reconcileTicker.Stop()
 
 	for {
 		select {
 		case <-s.ctx.Done():
 			return
-		case taskID := <-s.updates:
-			if taskID != "" {
-				pending[taskID] = pending[taskID]
+		case <-s.wake:
+			if s.isLeader() {
+				s.drainJobs()
 			}
 		case <-ticker.C:
-			pending = s.process(pending)
+			if s.isLeader() {
+				s.run()
+			}
+		case <-reconcileTicker.C:
+			if s.isLeader() {
+				s.reconcile()
+			}
 		}
 	}
 }
 
-func (s *Scheduler) process(pending map[string]int) map[string]int {
-	ctx := s.ctx
-	if ctx == nil {
-		return pending
-	}
+// run is the periodic pass: drain the job queue, sweep past the cursor and
+// record the outcome, including errors of wake-ups and reconciles since the
+// previous run.
+func (s *Scheduler) run() {
+	s.sweep(s.drainJobs())
 
-	maxRetries := s.cfg.SchedulerMaxRetries
-	if maxRetries <= 0 {
-		maxRetries = 3
+	cause := ""
+	if s.runErr != nil {
+		cause = s.runErr.Error()
+		s.runErr = nil
 	}
-
-	nextPending := make(map[string]int, len(pending))
-	for id, count := range pending {
-		nextPending[id] = count
+	if err := s.storage.RecordIndexerRun(s.ctx, cause); err != nil {
+		s.logger.Error("failed to record indexer run", zap.Error(err))
 	}
+}
 
-	processed := make(map[string]struct{})
+// fail remembers err as the last error of the current run.
+func (s *Scheduler) fail(err error) {
+	s.runErr = err
+}
 
-	if len(pending) > 0 {
-		ids := make([]string, 0, len(pending))
-		for id := range pending {
-			ids = append(ids, id)
-		}
+func (s *Scheduler) isLeader() bool {
+	return s.leader == nil || s.leader.IsLeader()
+}
+
+func (s *Scheduler) batchSize() int {
+	if s.cfg.SchedulerBatchSize > 0 {
+		return s.cfg.SchedulerBatchSize
+	}
+	return defaultBatchSize
+}
 
-		tasks, err := s.storage.GetTasksByIDs(ctx, ids)
+// drainJobs processes due jobs batch by batch until the queue has none left
+// and returns the ids of the tasks it synced.
+func (s *Scheduler) drainJobs() map[string]struct{} {
+	processed := make(map[string]struct{})
+	jobs := 0
+	for s.ctx.Err() == nil {
+		count, err := s.processJobs(processed)
 		if err != nil {
-			s.logger.Error("failed to fetch pending tasks for indexing", zap.Error(err))
-			return nextPending
+			s.logger.Error("failed to process index jobs", zap.Error(err))
+			s.fail(err)
+			break
 		}
+		jobs += count
+		if count < s.batchSize() {
+			break
+		}
+		// Long drains are reindexes; report how far they got.
+		s.logger.Info("index jobs in progress", zap.Int("jobs", jobs), zap.Int("tasks_synced", len(processed)))
+	}
+	if jobs > 0 {
+		s.logger.Info("index jobs drained", zap.Int("jobs", jobs), zap.Int("tasks_synced", len(processed)))
+	}
+	return processed
+}
 
-		taskMap := make(map[string]*domain.Task, len(tasks))
-		for _, task := range tasks {
-			if task != nil && task.ID != "" {
-				taskMap[task.ID] = task
-			}
+// processJobs claims one batch of due jobs and syncs their tasks outside the
+// claiming transaction, so that calls to the search engine hold no row locks.
+// Several jobs for the same task are settled by a single sync.
+func (s *Scheduler) processJobs(processed map[string]struct{}) (int, error) {
+	jobs, err := s.claimJobs()
+	if err != nil || len(jobs) == 0 {
+		return 0, err
+	}
+
+	jobsByTask := make(map[string][]*domain.IndexJob)
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		if _, ok := jobsByTask[job.TaskID]; !ok {
+			ids = append(ids, job.TaskID)
 		}
+		jobsByTask[job.TaskID] = append(jobsByTask[job.TaskID], job)
+	}
+
+	tasks, err := s.storage.GetTasksByIDs(s.ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	failures := s.syncTasks(s.ctx, ids, taskMap(tasks))
 
+	err = s.storage.Do(s.ctx, func(ctx context.Context) error {
+		completed := make([]int64, 0, len(jobs))
 		for _, id := range ids {
-			task := taskMap[id]
-			if task == nil {
-				continue
-			}
-			if s.indexTask(ctx, task) {
-				delete(nextPending, id)
-				processed[id] = struct{}{}
+			if syncErr, failed := failures[id]; failed {
+				for _, job := range jobsByTask[id] {
+					if err := s.recordFailure(ctx, job, syncErr); err != nil {
+						return err
+					}
+				}
 				continue
 			}
-			retries := pending[id] + 1
-			if retries <= maxRetries {
-				nextPending[id] = retries
-			} else {
-				delete(nextPending, id)
-				s.logger.Error("max retries exceeded when indexing task", zap.String("task_id", id))
+
+			for _, job := range jobsByTask[id] {
+				completed = append(completed, job.ID)
 			}
 		}
+		return s.storage.CompleteIndexJobs(ctx, completed)
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	cursor, err := s.storage.LoadSearchCursor(ctx)
+	for _, id := range ids {
+		if _, failed := failures[id]; !failed {
+			processed[id] = struct{}{}
+		}
+	}
+	return len(jobs), nil
+}
+
+// claimJobs locks due jobs and pushes their next attempt back by jobClaimTTL.
+// Only the leader drains jobs, so the claim matters when a scheduler dies or
+// loses the role mid-batch: its jobs are picked up again once it expires.
+func (s *Scheduler) claimJobs() ([]*domain.IndexJob, error) {
+	var jobs []*domain.IndexJob
+	err := s.storage.Do(s.ctx, func(ctx context.Context) error {
+		var err error
+		jobs, err = s.storage.LockDueIndexJobs(ctx, s.batchSize())
+		if err != nil || len(jobs) == 0 {
+			return err
+		}
+
+		ids := make([]int64, 0, len(jobs))
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		return s.storage.ClaimIndexJobs(ctx, ids, time.Now().Add(jobClaimTTL))
+	})
 	if err != nil {
-		s.logger.Error("failed to load search cursor", zap.Error(err))
-		return nextPending
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *Scheduler) recordFailure(ctx context.Context, job *domain.IndexJob, cause error) error {
+	maxRetries := s.cfg.SchedulerMaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	status := domain.IndexJobPending
+	if job.Attempts+1 > maxRetries {
+		status = domain.IndexJobDead
+		s.logger.Error(
+			"index job exhausted its retries",
+			zap.Int64("job_id", job.ID),
+			zap.String("task_id", job.TaskID),
+			zap.Int("attempts", job.Attempts+1),
+		)
+	}
+
+	return s.storage.RecordIndexJobFailure(ctx, job.ID, status, cause.Error(), time.Now().Add(s.backoff(job.Attempts)))
+}
+
+func (s *Scheduler) backoff(attempts int) time.Duration {
+	maxBackoff := s.cfg.JobMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
 	}
 
-	batchSize := s.cfg.SchedulerBatchSize
-	if batchSize <= 0 {
-		batchSize = 200
+	delay := baseBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
 	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
 
-	fetchCursor := cursor
-	maxIndexedTime := cursor
+// sweep syncs tasks updated after the saved cursor that the job queue did not
+// already cover. Tasks that fail are handed to the job queue, which owns
+// their retries. The cursor only moves past a contiguous run of tasks that
+// were synced or handed over, so nothing behind it is left untracked.
+func (s *Scheduler) sweep(processed map[string]struct{}) {
+	ctx := s.ctx
+
+	cursor, err := s.storage.LoadSearchCursor(ctx)
+	if err != nil {
+		s.logger.Error("failed to load search cursor", zap.Error(err))
+		s.fail(err)
+		return
+	}
+
+	batchSize := s.batchSize()
+	advanced := cursor
 
 	for {
-		tasks, err := s.storage.GetTasksUpdatedAfter(ctx, fetchCursor, batchSize)
+		tasks, err := s.storage.GetTasksUpdatedAfter(ctx, advanced, batchSize)
 		if err != nil {
 			s.logger.Error("failed to fetch tasks for indexing", zap.Error(err))
+			s.fail(err)
 			break
 		}
 		if len(tasks) == 0 {
 			break
 		}
 
+		ids := make([]string, 0, len(tasks))
 		for _, task := range tasks {
 			if task == nil || task.ID == "" {
 				continue
 			}
-			if _, alreadyProcessed := processed[task.ID]; alreadyProcessed {
-				continue
-			}
-			if _, pendingAgain := nextPending[task.ID]; pendingAgain {
-				continue
+			if _, alreadyProcessed := processed[task.ID]; !alreadyProcessed {
+				ids = append(ids, task.ID)
 			}
+		}
 
-			if s.indexTask(ctx, task) {
-				processed[task.ID] = struct{}{}
-				if task.UpdatedAt.After(maxIndexedTime) {
-					maxIndexedTime = task.UpdatedAt
-				}
+		failures := s.syncTasks(ctx, ids, taskMap(tasks))
+
+		failed := make([]string, 0, len(failures))
+		for _, id := range ids {
+			if _, ok := failures[id]; ok {
+				failed = append(failed, id)
 			} else {
-				nextPending[task.ID] = 1
+				processed[id] = struct{}{}
 			}
 		}
 
-		last := tasks[len(tasks)-1]
-		if last == nil || !last.UpdatedAt.After(fetchCursor) {
-			break
+		handedOver := true
+		if err := s.storage.EnqueueIndexJobs(ctx, failed); err != nil {
+			s.logger.Error("failed to enqueue index jobs for failed tasks", zap.Error(err))
+			s.fail(err)
+			handedOver = false
+		}
+
+		stopped := false
+		for _, task := range tasks {
+			if task == nil {
+				continue
+			}
+			if _, ok := failures[task.ID]; ok && !handedOver {
+				stopped = true
+				break
+			}
+			advanced = domain.SearchCursor{UpdatedAt: task.UpdatedAt, ID: task.ID}
 		}
-		fetchCursor = last.UpdatedAt
 
-		if len(tasks) < batchSize {
+		if stopped || len(tasks) < batchSize {
 			break
 		}
 	}
 
-	if maxIndexedTime.After(cursor) {
-		if err := s.storage.SaveSearchCursor(ctx, maxIndexedTime); err != nil {
+	if advanced != cursor {
+		if err := s.storage.SaveSearchCursor(ctx, advanced); err != nil {
 			s.logger.Error("failed to save search cursor", zap.Error(err))
+			s.fail(err)
 		}
 	}
+}
+
+// syncTasks brings the search engine in line with tasks for every id: present
+// tasks are indexed, missing (deleted) and closed ones removed. Index calls go
+// out in batches of Search.IndexBatchSize and, with the deletes, run on up to
+// Search.IndexWorkers goroutines. Bookkeeping is written afterwards on the
+// calling goroutine. Callers must not hold a transaction: the engine calls
+// can take as long as their retries. It returns the error of every id that
+// failed.
+func (s *Scheduler) syncTasks(ctx context.Context, ids []string, tasks map[string]*domain.Task) map[string]error {
+	toIndex := make([]searchintegration.IndexTask, 0, len(ids))
+	toDelete := make([]string, 0)
+	for _, id := range ids {
+		task := tasks[id]
+		if task == nil || task.Closed() {
+			toDelete = append(toDelete, id)
+			continue
+		}
+		toIndex = append(toIndex, indexPayload(task))
+	}
+
+	var (
+		mu        sync.Mutex
+		failures  = make(map[string]error)
+		indexed   = make([]string, 0, len(toIndex))
+		unindexed = make([]string, 0, len(toDelete))
+	)
+
+	work := make([]func(), 0, len(toDelete)+len(toIndex)/s.indexBatchSize()+1)
+	for start := 0; start < len(toIndex); start += s.indexBatchSize() {
+		chunk := toIndex[start:min(start+s.indexBatchSize(), len(toIndex))]
+		work = append(work, func() {
+			results, err := s.indexChunk(ctx, chunk)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, result := range results {
+				if result.Err != nil {
+					failures[result.TaskID] = result.Err
+				} else {
+					indexed = append(indexed, result.TaskID)
+				}
+			}
+			if err != nil {
+				for _, task := range chunk {
+					failures[task.TaskID] = err
+				}
+			}
+		})
+	}
+	for _, id := range toDelete {
+		work = append(work, func() {
+			err := s.limiter.wait(ctx, 1)
+			if err == nil {
+				err = s.client.DeleteTask(ctx, id)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[id] = err
+			} else {
+				unindexed = append(unindexed, id)
+			}
+		})
+	}
+
+	s.runWorkers(work)
 
-	return nextPending
+	for id, err := range failures {
+		s.logger.Error("failed to sync task with search", zap.Error(err), zap.String("task_id", id))
+		s.fail(fmt.Errorf("task %s: %w", id, err))
+	}
+
+	changed := make([]string, 0, len(indexed)+len(unindexed))
+	for _, id := range indexed {
+		if err := s.storage.MarkTaskIndexed(ctx, id); err != nil {
+			failures[id] = err
+			continue
+		}
+		changed = append(changed, id)
+	}
+	for _, id := range unindexed {
+		if err := s.storage.MarkTaskUnindexed(ctx, id); err != nil {
+			failures[id] = err
+			continue
+		}
+		changed = append(changed, id)
+	}
+	s.invalidate(changed)
+
+	return failures
 }
 
-func (s *Scheduler) indexTask(ctx context.Context, task *domain.Task) bool {
-	payload := searchintegration.IndexTask{
+// indexChunk sends one bulk index call paced by Search.IndexRateLimit. When
+// the call fails as a whole, err is set and results is nil.
+func (s *Scheduler) indexChunk(ctx context.Context, chunk []searchintegration.IndexTask) ([]searchintegration.IndexResult, error) {
+	if err := s.limiter.wait(ctx, len(chunk)); err != nil {
+		return nil, err
+	}
+	return s.client.IndexTasks(ctx, chunk)
+}
+
+// runWorkers runs work on a bounded pool and returns when all of it is done.
+func (s *Scheduler) runWorkers(work []func()) {
+	workers := s.cfg.IndexWorkers
+	if workers <= 0 {
+		workers = defaultIndexWorkers
+	}
+	workers = min(workers, len(work))
+
+	queue := make(chan func())
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for fn := range queue {
+				fn()
+			}
+		}()
+	}
+
+	for _, fn := range work {
+		queue <- fn
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (s *Scheduler) indexBatchSize() int {
+	if s.cfg.IndexBatchSize > 0 {
+		return s.cfg.IndexBatchSize
+	}
+	return defaultIndexBatchSize
+}
+
+func (s *Scheduler) invalidate(taskIDs []string) {
+	if s.cache != nil && len(taskIDs) > 0 {
+		s.cache.InvalidateTasks(taskIDs...)
+	}
+}
+
+// reconcile removes index entries whose task was deleted or closed without
+// the change reaching the scheduler, e.g. because it was made outside the
+// service. Failures are picked up by the next sweep.
+func (s *Scheduler) reconcile() {
+	ctx := s.ctx
+
+	ids, err := s.storage.GetOrphanedIndexEntries(ctx, s.batchSize())
+	if err != nil {
+		s.logger.Error("failed to fetch orphaned index entries", zap.Error(err))
+		s.fail(err)
+		return
+	}
+
+	failures := s.syncTasks(ctx, ids, nil)
+
+	if len(ids) > 0 {
+		s.logger.Info("search index reconciled", zap.Int("orphans", len(ids)), zap.Int("removed", len(ids)-len(failures)))
+	}
+}
+
+func taskMap(tasks []*domain.Task) map[string]*domain.Task {
+	result := make(map[string]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		if task != nil && task.ID != "" {
+			result[task.ID] = task
+		}
+	}
+	return result
+}
+
+func indexPayload(task *domain.Task) searchintegration.IndexTask {
+	return searchintegration.IndexTask{
 		TaskID:   task.ID,
 		TaskName: strings.TrimSpace(task.Name),
 		TaskDesc: strings.TrimSpace(task.Description),
 		TaskType: taskTypeFromMeta(task.Meta),
 		GeoData:  geoFromMeta(task.Meta),
-	}
 
-	if err := s.client.IndexTask(ctx, payload); err != nil {
-		s.logger.Error("failed to index task in search", zap.Error(err), zap.String("task_id", task.ID))
-		return false
+		CustomerID:       task.CustomerID,
+		VerificationType: task.VerificationType.String(),
+		Cost:             task.Cost,
+		MembersCount:     task.MembersCount,
+		OpenSlots:        max(task.MembersCount-task.ParticipantsApproved, 0),
+		CreatedAt:        task.CreatedAt,
+		UpdatedAt:        task.UpdatedAt,
 	}
-
-	return true
 }
 
 func taskTypeFromMeta(meta json.RawMessage) string {
@@ -321,4 +716,3 @@ func metaToMap(meta json.RawMessage) map[string]string {
 
 	return result
 }
-
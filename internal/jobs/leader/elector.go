@@ -0,0 +1,221 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"DobrikaDev/task-service/utils/config"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRenewInterval = 5 * time.Second
+	defaultQueryTimeout  = 2 * time.Second
+)
+
+// The lease is a session-level advisory lock held on a dedicated connection.
+// Postgres drops it when that session ends, so a crashed leader is replaced
+// as soon as its connection is gone.
+const lockHeldQuery = `SELECT EXISTS (
+	SELECT 1 FROM pg_locks
+	WHERE locktype = 'advisory'
+		AND pid = pg_backend_pid()
+		AND granted
+		AND ((classid::bigint << 32) | objid::bigint) = $1
+)`
+
+// Elector campaigns for leadership of one named role among all replicas
+// sharing the database. Every RenewInterval the leader checks that it still
+// holds the lock and followers try to take it; Stop releases the lock so that
+// another replica takes over on its next attempt.
+type Elector struct {
+	db     *sqlx.DB
+	name   string
+	key    int64
+	cfg    config.LeaderConfig
+	logger *zap.Logger
+
+	leader atomic.Bool
+	conn   *sql.Conn
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewElector(db *sqlx.DB, name string, cfg config.LeaderConfig, logger *zap.Logger) *Elector {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Elector{
+		db:     db,
+		name:   name,
+		key:    lockKey(name),
+		cfg:    cfg,
+		logger: logger.With(zap.String("role", name)),
+		done:   make(chan struct{}),
+	}
+}
+
+// lockKey maps name onto a non-negative advisory lock key, which keeps it
+// comparable with the classid/objid pair pg_locks reports.
+func lockKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+	return int64(hash.Sum64() &^ (1 << 63))
+}
+
+// IsLeader reports whether this replica currently holds the role.
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+func (e *Elector) Start(parent context.Context) {
+	if e.db == nil {
+		e.logger.Warn("leader elector not started: missing database")
+		return
+	}
+
+	e.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		e.ctx, e.cancel = context.WithCancel(parent)
+		go e.loop()
+	})
+}
+
+// Stop ends the campaign and hands leadership over by releasing the lock.
+// Stop the jobs guarded by the elector first so that none of them runs
+// without the lock.
+func (e *Elector) Stop() {
+	e.stopOnce.Do(func() {
+		if e.cancel == nil {
+			return
+		}
+		e.cancel()
+		<-e.done
+	})
+}
+
+func (e *Elector) loop() {
+	defer close(e.done)
+	defer e.resign()
+
+	interval := e.cfg.RenewInterval
+	if interval <= 0 {
+		interval = defaultRenewInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if e.IsLeader() {
+			e.renew()
+		} else {
+			e.campaign()
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) campaign() {
+	ctx, cancel := context.WithTimeout(e.ctx, e.queryTimeout())
+	defer cancel()
+
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		e.logger.Error("failed to get connection for leader election", zap.Error(err))
+		return
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired); err != nil {
+		e.logger.Error("failed to try leader lock", zap.Error(err))
+		_ = conn.Close()
+		return
+	}
+	if !acquired {
+		_ = conn.Close()
+		return
+	}
+
+	e.conn = conn
+	e.leader.Store(true)
+	e.logger.Info("acquired leadership")
+}
+
+// renew confirms that the session still holds the lock. Any doubt counts as
+// a loss: leadership is dropped locally before another replica can gain it.
+func (e *Elector) renew() {
+	ctx, cancel := context.WithTimeout(e.ctx, e.queryTimeout())
+	defer cancel()
+
+	var held bool
+	err := e.conn.QueryRowContext(ctx, lockHeldQuery, e.key).Scan(&held)
+	if err == nil && held {
+		return
+	}
+	if e.ctx.Err() != nil {
+		return
+	}
+
+	e.logger.Warn("lost leadership", zap.Error(err), zap.Bool("lock_held", held))
+	e.leader.Store(false)
+	discard(e.conn)
+	e.conn = nil
+}
+
+func (e *Elector) resign() {
+	if !e.IsLeader() {
+		return
+	}
+	e.leader.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.queryTimeout())
+	defer cancel()
+
+	if _, err := e.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+		e.logger.Warn("failed to release leader lock, closing its connection", zap.Error(err))
+		discard(e.conn)
+	} else {
+		_ = e.conn.Close()
+	}
+	e.conn = nil
+
+	e.logger.Info("released leadership")
+}
+
+// discard closes the session behind conn instead of returning it to the
+// pool, which also frees any advisory lock it may still hold.
+func discard(conn *sql.Conn) {
+	_ = conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	_ = conn.Close()
+}
+
+func (e *Elector) queryTimeout() time.Duration {
+	if e.cfg.QueryTimeout > 0 {
+		return e.cfg.QueryTimeout
+	}
+	return defaultQueryTimeout
+}
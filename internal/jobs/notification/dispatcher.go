@@ -0,0 +1,202 @@
+package notification
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/internal/integration/notifier"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 100
+	defaultMaxAttempts  = 5
+	defaultSendTimeout  = 5 * time.Second
+	defaultMaxBackoff   = 30 * time.Minute
+	baseBackoff         = 10 * time.Second
+	claimMargin         = 30 * time.Second
+)
+
+type DispatcherStorage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	LockDueNotifications(ctx context.Context, limit, maxAttempts int) ([]*domain.Notification, error)
+	ClaimNotifications(ctx context.Context, ids []string, until time.Time) error
+	MarkNotificationSent(ctx context.Context, id string) error
+	MarkNotificationFailed(ctx context.Context, id, cause string, retryAt time.Time) error
+}
+
+// Dispatcher pushes due notifications to their external channels through a
+// Notifier. Notifications are claimed in a short transaction and sent outside
+// of it; a failed one is retried with exponential backoff until MaxAttempts.
+type Dispatcher struct {
+	storage  DispatcherStorage
+	notifier notifier.Notifier
+	cfg      config.NotificationConfig
+	logger   *zap.Logger
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewDispatcher(storage DispatcherStorage, notifier notifier.Notifier, cfg config.NotificationConfig, logger *zap.Logger) *Dispatcher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Dispatcher{
+		storage:  storage,
+		notifier: notifier,
+		cfg:      cfg,
+		logger:   logger,
+		done:     make(chan struct{}),
+	}
+}
+
+func (d *Dispatcher) Start(parent context.Context) {
+	if d.storage == nil || d.notifier == nil {
+		d.logger.Warn("notification dispatcher not started: missing dependencies")
+		return
+	}
+
+	d.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		d.ctx, d.cancel = context.WithCancel(parent)
+		go d.loop()
+	})
+}
+
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		if d.cancel == nil {
+			return
+		}
+		d.cancel()
+		<-d.done
+	})
+}
+
+func (d *Dispatcher) loop() {
+	defer close(d.done)
+
+	interval := d.cfg.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatchBatch()
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchBatch() {
+	batchSize := d.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	notifications, err := d.claim(batchSize)
+	if err != nil {
+		d.logger.Error("failed to claim notifications", zap.Error(err))
+		return
+	}
+
+	for _, notification := range notifications {
+		if err := d.send(d.ctx, notification); err != nil {
+			d.logger.Error("failed to record notification attempt", zap.Error(err), zap.String("id", notification.ID))
+		}
+	}
+}
+
+// claim locks due notifications and moves their send_after past the time the
+// batch may take to send, so that no other replica picks them up meanwhile.
+// A crashed dispatcher leaves them to be retried once the claim expires.
+func (d *Dispatcher) claim(limit int) ([]*domain.Notification, error) {
+	maxAttempts := d.cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	var notifications []*domain.Notification
+	err := d.storage.Do(d.ctx, func(ctx context.Context) error {
+		var err error
+		notifications, err = d.storage.LockDueNotifications(ctx, limit, maxAttempts)
+		if err != nil || len(notifications) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(notifications))
+		for _, notification := range notifications {
+			ids = append(ids, notification.ID)
+		}
+		until := time.Now().Add(time.Duration(len(notifications))*d.sendTimeout() + claimMargin)
+		return d.storage.ClaimNotifications(ctx, ids, until)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, notification *domain.Notification) error {
+	sendCtx, cancel := context.WithTimeout(ctx, d.sendTimeout())
+	defer cancel()
+
+	for _, channel := range notification.ChannelList() {
+		if err := d.notifier.Notify(sendCtx, channel, notification); err != nil {
+			d.logger.Warn(
+				"failed to send notification",
+				zap.Error(err),
+				zap.String("id", notification.ID),
+				zap.String("channel", channel.String()),
+				zap.Int("attempts", notification.Attempts+1),
+			)
+			retryAt := time.Now().Add(d.backoff(notification.Attempts))
+			return d.storage.MarkNotificationFailed(ctx, notification.ID, err.Error(), retryAt)
+		}
+	}
+
+	return d.storage.MarkNotificationSent(ctx, notification.ID)
+}
+
+func (d *Dispatcher) sendTimeout() time.Duration {
+	if d.cfg.SendTimeout > 0 {
+		return d.cfg.SendTimeout
+	}
+	return defaultSendTimeout
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	maxBackoff := d.cfg.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	delay := baseBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+)
+
+type recordedAttempt struct {
+	sent    bool
+	retryAt time.Time
+}
+
+// memoryStorage hands out fixed notifications and records what the
+// dispatcher does with them.
+type memoryStorage struct {
+	due      []*domain.Notification
+	inTx     bool
+	claimed  time.Time
+	attempts map[string]recordedAttempt
+}
+
+func (s *memoryStorage) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.inTx = true
+	defer func() { s.inTx = false }()
+	return fn(ctx)
+}
+
+func (s *memoryStorage) LockDueNotifications(context.Context, int, int) ([]*domain.Notification, error) {
+	return s.due, nil
+}
+
+func (s *memoryStorage) ClaimNotifications(_ context.Context, _ []string, until time.Time) error {
+	s.claimed = until
+	return nil
+}
+
+func (s *memoryStorage) MarkNotificationSent(_ context.Context, id string) error {
+	s.attempts[id] = recordedAttempt{sent: true}
+	return nil
+}
+
+func (s *memoryStorage) MarkNotificationFailed(_ context.Context, id, _ string, retryAt time.Time) error {
+	s.attempts[id] = recordedAttempt{retryAt: retryAt}
+	return nil
+}
+
+// failingNotifier fails the notifications listed in failing and checks that
+// it is never called while the storage holds a transaction.
+type failingNotifier struct {
+	t       *testing.T
+	storage *memoryStorage
+	failing map[string]bool
+}
+
+func (n *failingNotifier) Notify(_ context.Context, _ domain.NotificationChannel, notification *domain.Notification) error {
+	if n.storage.inTx {
+		n.t.Error("notification sent inside the locking transaction")
+	}
+	if n.failing[notification.ID] {
+		return errors.New("provider unavailable")
+	}
+	return nil
+}
+
+func TestDispatcherSendsOutsideTransaction(t *testing.T) {
+	storage := &memoryStorage{
+		due: []*domain.Notification{
+			{ID: "failing", Channels: "push", Attempts: 2},
+			{ID: "ok", Channels: "push,email"},
+		},
+		attempts: make(map[string]recordedAttempt),
+	}
+	notifier := &failingNotifier{t: t, storage: storage, failing: map[string]bool{"failing": true}}
+
+	dispatcher := NewDispatcher(storage, notifier, config.NotificationConfig{}, nil)
+	dispatcher.ctx = context.Background()
+
+	before := time.Now()
+	dispatcher.dispatchBatch()
+
+	if !storage.claimed.After(before) {
+		t.Fatal("notifications were not claimed before sending")
+	}
+	if !storage.attempts["ok"].sent {
+		t.Fatal("a failed notification kept the rest of the batch from being marked sent")
+	}
+
+	failed := storage.attempts["failing"]
+	if failed.sent {
+		t.Fatal("failed notification marked sent")
+	}
+	if wait := failed.retryAt.Sub(before); wait < 4*baseBackoff {
+		t.Fatalf("retry after the third attempt scheduled in %s, want at least %s", wait, 4*baseBackoff)
+	}
+}
+
+func TestDispatcherBackoffIsCapped(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, config.NotificationConfig{MaxBackoff: time.Minute}, nil)
+
+	if got := dispatcher.backoff(0); got != baseBackoff {
+		t.Errorf("backoff(0) = %s, want %s", got, baseBackoff)
+	}
+	if got := dispatcher.backoff(20); got != time.Minute {
+		t.Errorf("backoff(20) = %s, want the one minute cap", got)
+	}
+}
@@ -0,0 +1,127 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	eventspb "DobrikaDev/task-service/internal/generated/proto/events"
+	"DobrikaDev/task-service/internal/jobs/outbox"
+	"DobrikaDev/task-service/internal/storage/sql"
+
+	"github.com/google/uuid"
+)
+
+type PublisherStorage interface {
+	GetTaskByID(ctx context.Context, id string) (*domain.Task, error)
+	GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error)
+	CreateNotifications(ctx context.Context, notifications []*domain.Notification) error
+}
+
+// Publisher is an outbox.EventPublisher that turns participation events into
+// inbox notifications: approvals and rejections for the volunteer, joins and
+// confirmations for the customer. External channels are sent later by the
+// Dispatcher, after the recipient's quiet hours.
+type Publisher struct {
+	storage PublisherStorage
+	now     func() time.Time
+}
+
+func NewPublisher(storage PublisherStorage) *Publisher {
+	return &Publisher{storage: storage, now: time.Now}
+}
+
+type recipientEvent struct {
+	recipientID      string
+	notificationType domain.NotificationType
+	taskID           string
+	userID           string
+}
+
+func (p *Publisher) Publish(ctx context.Context, message outbox.Message) error {
+	if message.Type != domain.EventUserTaskCreated && message.Type != domain.EventUserTaskStatusChanged {
+		return nil
+	}
+
+	envelope, err := message.Envelope()
+	if err != nil {
+		return err
+	}
+
+	event, ok := recipientEventFromEnvelope(envelope)
+	if !ok || event.recipientID == "" {
+		return nil
+	}
+
+	task, err := p.storage.GetTaskByID(ctx, event.taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrTaskNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	preferences, err := p.storage.GetNotificationPreferences(ctx, event.recipientID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNotificationPreferencesNotFound) {
+			return err
+		}
+		preferences = domain.DefaultNotificationPreferences(event.recipientID)
+	}
+
+	title, body, err := render(event.notificationType, templateData{
+		TaskID:   task.ID,
+		TaskName: task.Name,
+		UserID:   event.userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.storage.CreateNotifications(ctx, []*domain.Notification{{
+		ID:          uuid.NewString(),
+		RecipientID: event.recipientID,
+		EventID:     envelope.GetId(),
+		Type:        event.notificationType,
+		TaskID:      task.ID,
+		Title:       title,
+		Body:        body,
+		Channels:    preferences.Channels,
+		SendAfter:   preferences.NextSendTime(p.now()),
+	}})
+}
+
+func recipientEventFromEnvelope(envelope *eventspb.Envelope) (recipientEvent, bool) {
+	switch payload := envelope.GetPayload().(type) {
+	case *eventspb.Envelope_UserTaskCreated:
+		return recipientEvent{
+			recipientID:      payload.UserTaskCreated.GetCustomerId(),
+			notificationType: domain.NotificationParticipantJoined,
+			taskID:           payload.UserTaskCreated.GetTaskId(),
+			userID:           payload.UserTaskCreated.GetUserId(),
+		}, true
+	case *eventspb.Envelope_UserTaskStatusChanged:
+		changed := payload.UserTaskStatusChanged
+		event := recipientEvent{
+			taskID: changed.GetTaskId(),
+			userID: changed.GetUserId(),
+		}
+		switch domain.Status(changed.GetStatus()) {
+		case domain.StatusApproved:
+			event.recipientID = changed.GetUserId()
+			event.notificationType = domain.NotificationTaskApproved
+		case domain.StatusRejected:
+			event.recipientID = changed.GetUserId()
+			event.notificationType = domain.NotificationTaskRejected
+		case domain.StatusCompleted:
+			event.recipientID = changed.GetCustomerId()
+			event.notificationType = domain.NotificationParticipantConfirmed
+		default:
+			return recipientEvent{}, false
+		}
+		return event, true
+	default:
+		return recipientEvent{}, false
+	}
+}
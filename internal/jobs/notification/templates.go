@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"DobrikaDev/task-service/internal/domain"
+)
+
+type notificationTemplate struct {
+	title *template.Template
+	body  *template.Template
+}
+
+type templateData struct {
+	TaskID   string
+	TaskName string
+	UserID   string
+}
+
+var templates = map[domain.NotificationType]notificationTemplate{
+	domain.NotificationTaskApproved: newTemplate(
+		"Task approved",
+		`Your participation in "{{.TaskName}}" was approved. Thank you for helping!`,
+	),
+	domain.NotificationTaskRejected: newTemplate(
+		"Task rejected",
+		`Your participation in "{{.TaskName}}" was not approved.`,
+	),
+	domain.NotificationParticipantJoined: newTemplate(
+		"New participant",
+		`A volunteer joined "{{.TaskName}}".`,
+	),
+	domain.NotificationParticipantConfirmed: newTemplate(
+		"Participant awaits approval",
+		`A volunteer marked "{{.TaskName}}" as done and is waiting for your approval.`,
+	),
+}
+
+func newTemplate(title, body string) notificationTemplate {
+	return notificationTemplate{
+		title: template.Must(template.New("title").Parse(title)),
+		body:  template.Must(template.New("body").Parse(body)),
+	}
+}
+
+func render(notificationType domain.NotificationType, data templateData) (string, string, error) {
+	tmpl, ok := templates[notificationType]
+	if !ok {
+		return "", "", fmt.Errorf("notification: no template for %s", notificationType)
+	}
+
+	var title, body strings.Builder
+	if err := tmpl.title.Execute(&title, data); err != nil {
+		return "", "", fmt.Errorf("notification: render %s title: %w", notificationType, err)
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return "", "", fmt.Errorf("notification: render %s body: %w", notificationType, err)
+	}
+
+	return title.String(), body.String(), nil
+}
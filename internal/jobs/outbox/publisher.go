@@ -0,0 +1,167 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	eventspb "DobrikaDev/task-service/internal/generated/proto/events"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Message is one outbox event handed to a publisher. Payload is the
+// serialized events.Envelope.
+type Message struct {
+	ID            string
+	Type          domain.EventType
+	AggregateID   string
+	SchemaVersion int
+	Payload       []byte
+	CreatedAt     time.Time
+}
+
+// Envelope decodes the message payload.
+func (m Message) Envelope() (*eventspb.Envelope, error) {
+	envelope := new(eventspb.Envelope)
+	if err := proto.Unmarshal(m.Payload, envelope); err != nil {
+		return nil, fmt.Errorf("outbox: decode envelope %s: %w", m.ID, err)
+	}
+	return envelope, nil
+}
+
+// EventPublisher delivers outbox messages to the outside world. The relay
+// marks a message as published only after Publish returns nil, so publishers
+// may see the same message more than once and consumers must dedupe on ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, message Message) error
+}
+
+// LogPublisher writes every message to the application log.
+type LogPublisher struct {
+	logger *zap.Logger
+}
+
+func NewLogPublisher(logger *zap.Logger) *LogPublisher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &LogPublisher{logger: logger}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, message Message) error {
+	envelope, err := message.Envelope()
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info(
+		"domain event published",
+		zap.String("event_id", message.ID),
+		zap.String("event_type", message.Type.String()),
+		zap.String("aggregate_id", message.AggregateID),
+		zap.String("payload", protojson.Format(envelope)),
+	)
+	return nil
+}
+
+// FilePublisher appends every message as one JSON line to a file.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("outbox: open %s: %w", path, err)
+	}
+	return &FilePublisher{file: file}, nil
+}
+
+func (p *FilePublisher) Publish(_ context.Context, message Message) error {
+	envelope, err := message.Envelope()
+	if err != nil {
+		return err
+	}
+
+	payload, err := protojson.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("outbox: encode envelope %s: %w", message.ID, err)
+	}
+
+	line, err := json.Marshal(map[string]any{
+		"id":             message.ID,
+		"type":           message.Type,
+		"aggregate_id":   message.AggregateID,
+		"schema_version": message.SchemaVersion,
+		"created_at":     message.CreatedAt,
+		"payload":        json.RawMessage(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("outbox: encode message %s: %w", message.ID, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("outbox: write message %s: %w", message.ID, err)
+	}
+	return p.file.Sync()
+}
+
+func (p *FilePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.file.Close()
+}
+
+// MemoryPublisher keeps published messages in memory for local runs and tests.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(_ context.Context, message Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.messages...)
+}
+
+// MultiPublisher hands every message to each publisher in order and stops at
+// the first error; the relay then retries the message for all of them, so
+// every publisher has to tolerate duplicates.
+type MultiPublisher struct {
+	publishers []EventPublisher
+}
+
+func NewMultiPublisher(publishers ...EventPublisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, message Message) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
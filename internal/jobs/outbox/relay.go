@@ -0,0 +1,218 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 100
+	defaultMaxBackoff   = 5 * time.Minute
+	defaultRetention    = 7 * 24 * time.Hour
+	baseBackoff         = time.Second
+	cleanupInterval     = time.Hour
+)
+
+type Storage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoNested(ctx context.Context, fn func(ctx context.Context) error) error
+	LockPendingOutboxEvents(ctx context.Context, limit int) ([]*domain.OutboxEvent, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []int64) error
+	MarkOutboxEventFailed(ctx context.Context, id int64, cause string, nextAttemptAt time.Time) error
+	DeletePublishedOutboxEvents(ctx context.Context, olderThan time.Time) (int, error)
+}
+
+// Relay drains outbox_events and hands them to an EventPublisher. Delivery is
+// at-least-once: a crash between Publish and the commit that marks the row
+// published causes the message to be sent again. Events of one aggregate are
+// published in insertion order. Published events are kept for
+// Outbox.Retention and then deleted.
+type Relay struct {
+	storage   Storage
+	publisher EventPublisher
+	cfg       config.OutboxConfig
+	logger    *zap.Logger
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRelay(storage Storage, publisher EventPublisher, cfg config.OutboxConfig, logger *zap.Logger) *Relay {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Relay{
+		storage:   storage,
+		publisher: publisher,
+		cfg:       cfg,
+		logger:    logger,
+		done:      make(chan struct{}),
+	}
+}
+
+func (r *Relay) Start(parent context.Context) {
+	if r.storage == nil || r.publisher == nil {
+		r.logger.Warn("outbox relay not started: missing dependencies")
+		return
+	}
+
+	r.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		r.ctx, r.cancel = context.WithCancel(parent)
+		go r.loop()
+	})
+}
+
+// Stop cancels the relay and waits for the batch in flight to finish.
+func (r *Relay) Stop() {
+	r.stopOnce.Do(func() {
+		if r.cancel == nil {
+			return
+		}
+		r.cancel()
+		<-r.done
+	})
+}
+
+func (r *Relay) loop() {
+	defer close(r.done)
+
+	interval := r.cfg.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	cleanup := time.NewTicker(cleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			// A batch holds one event per aggregate, so keep draining while
+			// batches make progress; the next event of an aggregate becomes
+			// available once the previous one is published.
+			for r.ctx.Err() == nil {
+				if r.relayBatch() == 0 {
+					break
+				}
+			}
+		case <-cleanup.C:
+			r.deletePublished()
+		}
+	}
+}
+
+// relayBatch publishes one locked batch and returns how many events were
+// published. The batch holds at most one event per aggregate, so a failed
+// event only holds back the later events of its own aggregate. Each event is
+// published in a savepoint: publishers that write through the transaction
+// cannot abort it, so a failure is still recorded and the rest of the batch
+// is still committed.
+func (r *Relay) relayBatch() int {
+	batchSize := r.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	var published int
+	err := r.storage.Do(r.ctx, func(ctx context.Context) error {
+		events, err := r.storage.LockPendingOutboxEvents(ctx, batchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(events))
+		for _, event := range events {
+			err := r.storage.DoNested(ctx, func(ctx context.Context) error {
+				return r.publisher.Publish(ctx, messageFromEvent(event))
+			})
+			if err != nil {
+				r.logger.Warn(
+					"failed to publish outbox event",
+					zap.Error(err),
+					zap.String("event_id", event.EventID),
+					zap.Int("attempts", event.Attempts+1),
+				)
+				if err := r.storage.MarkOutboxEventFailed(ctx, event.ID, err.Error(), time.Now().Add(r.backoff(event.Attempts))); err != nil {
+					return err
+				}
+				continue
+			}
+			ids = append(ids, event.ID)
+		}
+
+		published = len(ids)
+		return r.storage.MarkOutboxEventsPublished(ctx, ids)
+	})
+	if err != nil {
+		r.logger.Error("failed to relay outbox events", zap.Error(err))
+		return 0
+	}
+
+	return published
+}
+
+// deletePublished removes events published longer than Outbox.Retention ago.
+// Every replica runs it; the deletes are idempotent.
+func (r *Relay) deletePublished() {
+	retention := r.cfg.Retention
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+
+	deleted, err := r.storage.DeletePublishedOutboxEvents(r.ctx, time.Now().Add(-retention))
+	if err != nil {
+		r.logger.Error("failed to delete published outbox events", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		r.logger.Info("published outbox events deleted", zap.Int("deleted", deleted))
+	}
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	maxBackoff := r.cfg.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	delay := baseBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+func messageFromEvent(event *domain.OutboxEvent) Message {
+	return Message{
+		ID:            event.EventID,
+		Type:          event.EventType,
+		AggregateID:   event.AggregateID,
+		SchemaVersion: event.SchemaVersion,
+		Payload:       event.Payload,
+		CreatedAt:     event.CreatedAt,
+	}
+}
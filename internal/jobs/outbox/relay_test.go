@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+)
+
+// memoryStorage hands out its pending events once.
+type memoryStorage struct {
+	pending   []*domain.OutboxEvent
+	nested    int
+	published []int64
+	failed    []int64
+}
+
+func (s *memoryStorage) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *memoryStorage) DoNested(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.nested++
+	return fn(ctx)
+}
+
+func (s *memoryStorage) LockPendingOutboxEvents(context.Context, int) ([]*domain.OutboxEvent, error) {
+	events := s.pending
+	s.pending = nil
+	return events, nil
+}
+
+func (s *memoryStorage) MarkOutboxEventsPublished(_ context.Context, ids []int64) error {
+	s.published = append(s.published, ids...)
+	return nil
+}
+
+func (s *memoryStorage) MarkOutboxEventFailed(_ context.Context, id int64, _ string, _ time.Time) error {
+	s.failed = append(s.failed, id)
+	return nil
+}
+
+func (s *memoryStorage) DeletePublishedOutboxEvents(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+type failingPublisher struct {
+	fail map[string]bool
+}
+
+func (p failingPublisher) Publish(_ context.Context, msg Message) error {
+	if p.fail[msg.ID] {
+		return errors.New("enqueue failed")
+	}
+	return nil
+}
+
+func TestRelayBatchIsolatesFailedEvents(t *testing.T) {
+	storage := &memoryStorage{pending: []*domain.OutboxEvent{
+		{ID: 1, EventID: "e1", AggregateID: "a"},
+		{ID: 2, EventID: "e2", AggregateID: "b"},
+		{ID: 3, EventID: "e3", AggregateID: "c"},
+	}}
+	publisher := failingPublisher{fail: map[string]bool{"e2": true}}
+
+	relay := NewRelay(storage, publisher, config.OutboxConfig{}, nil)
+	relay.ctx = context.Background()
+
+	if published := relay.relayBatch(); published != 2 {
+		t.Fatalf("published %d events, want 2", published)
+	}
+	if storage.nested != 3 {
+		t.Fatalf("%d savepoints, want one per event", storage.nested)
+	}
+	if !slices.Equal(storage.published, []int64{1, 3}) || !slices.Equal(storage.failed, []int64{2}) {
+		t.Fatalf("published %v and failed %v, want [1 3] and [2]", storage.published, storage.failed)
+	}
+}
@@ -0,0 +1,201 @@
+package searchcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	batchSize           = 500
+	writeTimeout        = 2 * time.Second
+	pruneInterval       = time.Minute
+	// retentionMargin keeps invalidations a little longer than the entries
+	// they target can live, to cover replicas that poll late.
+	retentionMargin = time.Minute
+)
+
+type Storage interface {
+	CreateSearchCacheInvalidations(ctx context.Context, taskIDs []string) error
+	GetSearchCacheInvalidationsAfter(ctx context.Context, afterID int64, limit int) ([]*domain.SearchCacheInvalidation, error)
+	GetLatestSearchCacheInvalidationID(ctx context.Context) (int64, error)
+	DeleteSearchCacheInvalidationsBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type cache interface {
+	InvalidateTasks(taskIDs ...string)
+}
+
+// Broadcaster is the cache invalidator of the search indexer. The indexer runs
+// on one replica only, while every replica caches results, so instead of
+// touching a local cache it stores the task ids for the Watcher of each
+// replica to apply.
+type Broadcaster struct {
+	storage Storage
+	logger  *zap.Logger
+}
+
+func NewBroadcaster(storage Storage, logger *zap.Logger) *Broadcaster {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Broadcaster{storage: storage, logger: logger}
+}
+
+// InvalidateTasks records taskIDs. A failed write is only logged: the cached
+// entries still expire after Search.CacheTTL.
+func (b *Broadcaster) InvalidateTasks(taskIDs ...string) {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	if err := b.storage.CreateSearchCacheInvalidations(ctx, taskIDs); err != nil {
+		b.logger.Error("failed to broadcast search cache invalidation", zap.Error(err), zap.Strings("task_ids", taskIDs))
+	}
+}
+
+// Watcher applies the invalidations recorded by the Broadcaster to the cache
+// of this replica every Search.CacheInvalidationInterval, and deletes the
+// ones old enough that no cached entry can predate them. Results stay stale
+// for at most one interval after the indexer changed the engine, or up to
+// Search.CacheTTL while the database cannot be polled.
+type Watcher struct {
+	storage Storage
+	cache   cache
+	cfg     config.SearchConfig
+	logger  *zap.Logger
+
+	lastID     int64
+	lastPruned time.Time
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWatcher(storage Storage, cache cache, cfg config.SearchConfig, logger *zap.Logger) *Watcher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Watcher{
+		storage: storage,
+		cache:   cache,
+		cfg:     cfg,
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+}
+
+func (w *Watcher) Start(parent context.Context) {
+	if w.storage == nil || w.cache == nil {
+		w.logger.Warn("search cache watcher not started: missing dependencies")
+		return
+	}
+
+	w.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		w.ctx, w.cancel = context.WithCancel(parent)
+		go w.loop()
+	})
+}
+
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		if w.cancel == nil {
+			return
+		}
+		w.cancel()
+		<-w.done
+	})
+}
+
+func (w *Watcher) loop() {
+	defer close(w.done)
+
+	interval := w.cfg.CacheInvalidationInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// The cache starts empty, so earlier invalidations do not apply to it.
+	started := false
+	for {
+		if !started {
+			started = w.skipHistory()
+		} else {
+			w.apply()
+			w.prune()
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// skipHistory moves past the invalidations recorded before this replica
+// started and reports whether it succeeded.
+func (w *Watcher) skipHistory() bool {
+	lastID, err := w.storage.GetLatestSearchCacheInvalidationID(w.ctx)
+	if err != nil {
+		w.logger.Error("failed to read search cache invalidations", zap.Error(err))
+		return false
+	}
+	w.lastID = lastID
+	return true
+}
+
+func (w *Watcher) apply() {
+	for w.ctx.Err() == nil {
+		invalidations, err := w.storage.GetSearchCacheInvalidationsAfter(w.ctx, w.lastID, batchSize)
+		if err != nil {
+			w.logger.Error("failed to read search cache invalidations", zap.Error(err))
+			return
+		}
+		if len(invalidations) == 0 {
+			return
+		}
+
+		taskIDs := make([]string, 0, len(invalidations))
+		for _, invalidation := range invalidations {
+			taskIDs = append(taskIDs, invalidation.TaskID)
+		}
+		w.cache.InvalidateTasks(taskIDs...)
+		w.lastID = invalidations[len(invalidations)-1].ID
+
+		if len(invalidations) < batchSize {
+			return
+		}
+	}
+}
+
+// prune deletes invalidations older than the cache TTL once per
+// pruneInterval. Every replica runs it; the deletes are idempotent.
+func (w *Watcher) prune() {
+	if time.Since(w.lastPruned) < pruneInterval {
+		return
+	}
+	w.lastPruned = time.Now()
+
+	before := time.Now().Add(-w.cfg.CacheTTL - retentionMargin)
+	if _, err := w.storage.DeleteSearchCacheInvalidationsBefore(w.ctx, before); err != nil {
+		w.logger.Error("failed to prune search cache invalidations", zap.Error(err))
+	}
+}
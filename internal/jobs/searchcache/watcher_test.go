@@ -0,0 +1,101 @@
+package searchcache
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+)
+
+// memoryStorage is a shared invalidation log, like the table every replica
+// reads.
+type memoryStorage struct {
+	mu            sync.Mutex
+	invalidations []*domain.SearchCacheInvalidation
+}
+
+func (s *memoryStorage) CreateSearchCacheInvalidations(_ context.Context, taskIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range taskIDs {
+		s.invalidations = append(s.invalidations, &domain.SearchCacheInvalidation{
+			ID:     int64(len(s.invalidations) + 1),
+			TaskID: id,
+		})
+	}
+	return nil
+}
+
+func (s *memoryStorage) GetSearchCacheInvalidationsAfter(_ context.Context, afterID int64, limit int) ([]*domain.SearchCacheInvalidation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]*domain.SearchCacheInvalidation, 0)
+	for _, invalidation := range s.invalidations {
+		if invalidation.ID > afterID && len(result) < limit {
+			result = append(result, invalidation)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStorage) GetLatestSearchCacheInvalidationID(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return int64(len(s.invalidations)), nil
+}
+
+func (s *memoryStorage) DeleteSearchCacheInvalidationsBefore(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+type recordingCache struct {
+	mu          sync.Mutex
+	invalidated []string
+}
+
+func (c *recordingCache) InvalidateTasks(taskIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidated = append(c.invalidated, taskIDs...)
+}
+
+func (c *recordingCache) get() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.invalidated)
+}
+
+func TestWatchersApplyBroadcastInvalidations(t *testing.T) {
+	storage := &memoryStorage{}
+	broadcaster := NewBroadcaster(storage, nil)
+
+	// Invalidations recorded before a replica starts do not concern its
+	// empty cache.
+	broadcaster.InvalidateTasks("before")
+
+	cfg := config.SearchConfig{CacheTTL: time.Minute, CacheInvalidationInterval: 10 * time.Millisecond}
+	caches := []*recordingCache{{}, {}}
+	for _, cache := range caches {
+		watcher := NewWatcher(storage, cache, cfg, nil)
+		watcher.Start(context.Background())
+		t.Cleanup(watcher.Stop)
+	}
+
+	// Let both watchers read the starting point.
+	time.Sleep(50 * time.Millisecond)
+	broadcaster.InvalidateTasks("a", "b")
+
+	deadline := time.Now().Add(time.Second)
+	for i, cache := range caches {
+		for !slices.Equal(cache.get(), []string{"a", "b"}) {
+			if time.Now().After(deadline) {
+				t.Fatalf("replica %d invalidated %v, want [a b]", i, cache.get())
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+}
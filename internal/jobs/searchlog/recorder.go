@@ -0,0 +1,229 @@
+package searchlog
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultQueueSize     = 1000
+	defaultBatchSize     = 100
+	defaultFlushInterval = 2 * time.Second
+	shutdownFlushTimeout = 5 * time.Second
+)
+
+type Storage interface {
+	CreateSearchEvents(ctx context.Context, events []*domain.SearchEvent) error
+	CreateSearchResults(ctx context.Context, pages []*domain.SearchResultPage) error
+	CreateSearchJoins(ctx context.Context, joins []*domain.SearchJoin) error
+}
+
+// Recorder writes search events and join attributions in the background so
+// that SearchTasks and UserJoinTask never wait for the analytics tables.
+// Records are buffered up to QueueSize and dropped when the buffer is full;
+// analytics are best effort, so a failed write is logged and dropped too.
+type Recorder struct {
+	storage Storage
+	cfg     config.AnalyticsConfig
+	logger  *zap.Logger
+
+	queue   chan record
+	dropped atomic.Int64
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type record struct {
+	search *domain.SearchEvent
+	page   *domain.SearchResultPage
+	join   *domain.SearchJoin
+}
+
+func NewRecorder(storage Storage, cfg config.AnalyticsConfig, logger *zap.Logger) *Recorder {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+
+	return &Recorder{
+		storage: storage,
+		cfg:     cfg,
+		logger:  logger,
+		queue:   make(chan record, cfg.QueueSize),
+		done:    make(chan struct{}),
+	}
+}
+
+func (r *Recorder) Start(parent context.Context) {
+	if r.storage == nil {
+		r.logger.Warn("search recorder not started: missing dependencies")
+		return
+	}
+
+	r.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		r.ctx, r.cancel = context.WithCancel(parent)
+		go r.loop()
+	})
+}
+
+// Stop cancels the recorder and waits until the buffered records are
+// written.
+func (r *Recorder) Stop() {
+	r.stopOnce.Do(func() {
+		if r.cancel == nil {
+			return
+		}
+		r.cancel()
+		<-r.done
+	})
+}
+
+// RecordSearch queues event without blocking.
+func (r *Recorder) RecordSearch(event *domain.SearchEvent) {
+	r.enqueue(record{search: event})
+}
+
+// RecordSearchPage queues page without blocking.
+func (r *Recorder) RecordSearchPage(page *domain.SearchResultPage) {
+	r.enqueue(record{page: page})
+}
+
+// RecordJoin queues join without blocking.
+func (r *Recorder) RecordJoin(join *domain.SearchJoin) {
+	r.enqueue(record{join: join})
+}
+
+func (r *Recorder) enqueue(rec record) {
+	select {
+	case r.queue <- rec:
+	default:
+		// Warn on the first drop and then every hundredth one.
+		if dropped := r.dropped.Add(1); dropped%100 == 1 {
+			r.logger.Warn("search analytics queue full, dropping records", zap.Int64("dropped", dropped))
+		}
+	}
+}
+
+func (r *Recorder) loop() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := newBatch(r.cfg.BatchSize)
+	for {
+		select {
+		case <-r.ctx.Done():
+			r.drain(batch)
+			return
+		case rec := <-r.queue:
+			batch.add(rec)
+			if batch.len() >= r.cfg.BatchSize {
+				r.flush(r.ctx, batch)
+			}
+		case <-ticker.C:
+			r.flush(r.ctx, batch)
+		}
+	}
+}
+
+// drain writes what is still buffered once the recorder is cancelled.
+func (r *Recorder) drain(batch *batch) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.ctx), shutdownFlushTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case rec := <-r.queue:
+			batch.add(rec)
+			if batch.len() >= r.cfg.BatchSize {
+				r.flush(ctx, batch)
+			}
+		default:
+			r.flush(ctx, batch)
+			return
+		}
+	}
+}
+
+// flush writes the buffered searches, pages and joins and resets batch. Pages
+// and joins are rows of their own keyed by search id, so they may be written
+// before their search, e.g. when another replica served the first page.
+func (r *Recorder) flush(ctx context.Context, batch *batch) {
+	if len(batch.searches) > 0 {
+		if err := r.storage.CreateSearchEvents(ctx, batch.searches); err != nil {
+			r.logger.Warn("failed to write search events", zap.Error(err), zap.Int("count", len(batch.searches)))
+		}
+	}
+	if len(batch.pages) > 0 {
+		if err := r.storage.CreateSearchResults(ctx, batch.pages); err != nil {
+			r.logger.Warn("failed to write search result pages", zap.Error(err), zap.Int("count", len(batch.pages)))
+		}
+	}
+	if len(batch.joins) > 0 {
+		if err := r.storage.CreateSearchJoins(ctx, batch.joins); err != nil {
+			r.logger.Warn("failed to write search joins", zap.Error(err), zap.Int("count", len(batch.joins)))
+		}
+	}
+	batch.reset()
+}
+
+type batch struct {
+	searches []*domain.SearchEvent
+	pages    []*domain.SearchResultPage
+	joins    []*domain.SearchJoin
+}
+
+func newBatch(size int) *batch {
+	return &batch{
+		searches: make([]*domain.SearchEvent, 0, size),
+		pages:    make([]*domain.SearchResultPage, 0, size),
+		joins:    make([]*domain.SearchJoin, 0, size),
+	}
+}
+
+func (b *batch) add(rec record) {
+	if rec.search != nil {
+		b.searches = append(b.searches, rec.search)
+	}
+	if rec.page != nil {
+		b.pages = append(b.pages, rec.page)
+	}
+	if rec.join != nil {
+		b.joins = append(b.joins, rec.join)
+	}
+}
+
+func (b *batch) len() int {
+	return len(b.searches) + len(b.pages) + len(b.joins)
+}
+
+func (b *batch) reset() {
+	b.searches = b.searches[:0]
+	b.pages = b.pages[:0]
+	b.joins = b.joins[:0]
+}
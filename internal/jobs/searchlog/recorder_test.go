@@ -0,0 +1,68 @@
+package searchlog
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/utils/config"
+)
+
+// memoryStorage collects what the recorder writes.
+type memoryStorage struct {
+	mu       sync.Mutex
+	searches []string
+	results  []string
+	joins    []string
+}
+
+func (s *memoryStorage) CreateSearchEvents(_ context.Context, events []*domain.SearchEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range events {
+		s.searches = append(s.searches, event.ID)
+	}
+	return nil
+}
+
+func (s *memoryStorage) CreateSearchResults(_ context.Context, pages []*domain.SearchResultPage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, page := range pages {
+		s.results = append(s.results, page.ResultIDs...)
+	}
+	return nil
+}
+
+func (s *memoryStorage) CreateSearchJoins(_ context.Context, joins []*domain.SearchJoin) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, join := range joins {
+		s.joins = append(s.joins, join.TaskID)
+	}
+	return nil
+}
+
+func TestRecorderWritesPagesQueuedBeforeTheirSearch(t *testing.T) {
+	storage := &memoryStorage{}
+	recorder := NewRecorder(storage, config.AnalyticsConfig{FlushInterval: time.Hour}, nil)
+
+	// The second page and a join from it arrive first, as when another
+	// replica served them before the first page was written.
+	recorder.RecordSearchPage(&domain.SearchResultPage{SearchID: "s1", ResultIDs: []string{"t3"}})
+	recorder.RecordJoin(&domain.SearchJoin{SearchID: "s1", TaskID: "t3"})
+	recorder.RecordSearch(&domain.SearchEvent{ID: "s1", ResultIDs: []string{"t1", "t2"}})
+
+	recorder.Start(context.Background())
+	recorder.Stop()
+
+	if !slices.Equal(storage.searches, []string{"s1"}) ||
+		!slices.Equal(storage.results, []string{"t3"}) ||
+		!slices.Equal(storage.joins, []string{"t3"}) {
+		t.Fatalf("wrote searches %v, results %v and joins %v; want [s1], [t3] and [t3]",
+			storage.searches, storage.results, storage.joins)
+	}
+}
@@ -0,0 +1,247 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	webhookintegration "DobrikaDev/task-service/internal/integration/webhook"
+	"DobrikaDev/task-service/utils/config"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval   = 2 * time.Second
+	defaultBatchSize      = 50
+	defaultMaxAttempts    = 8
+	defaultMaxBackoff     = time.Hour
+	defaultRequestTimeout = 5 * time.Second
+	defaultWorkers        = 8
+	baseBackoff           = 10 * time.Second
+	claimMargin           = 30 * time.Second
+)
+
+type DispatcherStorage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	LockDueWebhookDeliveries(ctx context.Context, limit int) ([]*domain.WebhookDelivery, error)
+	ClaimWebhookDeliveries(ctx context.Context, ids []int64, until time.Time) error
+	RecordWebhookAttempt(
+		ctx context.Context,
+		id int64,
+		status domain.WebhookDeliveryStatus,
+		statusCode int,
+		cause string,
+		nextAttemptAt time.Time,
+	) error
+}
+
+type sender interface {
+	Send(ctx context.Context, req webhookintegration.Request) (int, error)
+}
+
+// Dispatcher sends pending webhook deliveries and reschedules failures with
+// exponential backoff until MaxAttempts is reached, after which a delivery is
+// marked failed and only a manual redelivery sends it again. Deliveries are
+// claimed in a short transaction and sent outside of it by up to Workers
+// requests at once, so a slow receiver holds neither row locks nor the
+// deliveries of other customers.
+type Dispatcher struct {
+	storage DispatcherStorage
+	sender  sender
+	cfg     config.WebhookConfig
+	logger  *zap.Logger
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewDispatcher(storage DispatcherStorage, sender sender, cfg config.WebhookConfig, logger *zap.Logger) *Dispatcher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Dispatcher{
+		storage: storage,
+		sender:  sender,
+		cfg:     cfg,
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+}
+
+func (d *Dispatcher) Start(parent context.Context) {
+	if d.storage == nil || d.sender == nil {
+		d.logger.Warn("webhook dispatcher not started: missing dependencies")
+		return
+	}
+
+	d.startOnce.Do(func() {
+		if parent == nil {
+			parent = context.Background()
+		}
+
+		d.ctx, d.cancel = context.WithCancel(parent)
+		go d.loop()
+	})
+}
+
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		if d.cancel == nil {
+			return
+		}
+		d.cancel()
+		<-d.done
+	})
+}
+
+func (d *Dispatcher) loop() {
+	defer close(d.done)
+
+	interval := d.cfg.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatchBatch()
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchBatch() {
+	batchSize := d.cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	deliveries, err := d.claim(batchSize)
+	if err != nil {
+		d.logger.Error("failed to claim webhook deliveries", zap.Error(err))
+		return
+	}
+
+	queue := make(chan *domain.WebhookDelivery)
+	var wg sync.WaitGroup
+	for range min(d.workers(), len(deliveries)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range queue {
+				if err := d.deliver(d.ctx, delivery); err != nil {
+					d.logger.Error("failed to record webhook attempt", zap.Error(err), zap.Int64("delivery_id", delivery.ID))
+				}
+			}
+		}()
+	}
+	for _, delivery := range deliveries {
+		queue <- delivery
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// claim locks due deliveries and moves their next attempt past the time the
+// batch may take to send, so that no other replica picks them up meanwhile.
+// A crashed dispatcher leaves them to be retried once the claim expires.
+func (d *Dispatcher) claim(limit int) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	err := d.storage.Do(d.ctx, func(ctx context.Context) error {
+		var err error
+		deliveries, err = d.storage.LockDueWebhookDeliveries(ctx, limit)
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]int64, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		return d.storage.ClaimWebhookDeliveries(ctx, ids, time.Now().Add(d.claimTTL(len(deliveries))))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (d *Dispatcher) claimTTL(deliveries int) time.Duration {
+	timeout := d.cfg.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	rounds := (deliveries + d.workers() - 1) / d.workers()
+	return time.Duration(rounds)*timeout + claimMargin
+}
+
+func (d *Dispatcher) workers() int {
+	if d.cfg.Workers > 0 {
+		return d.cfg.Workers
+	}
+	return defaultWorkers
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	statusCode, err := d.sender.Send(ctx, webhookintegration.Request{
+		URL:       delivery.URL,
+		Secret:    delivery.Secret,
+		EventID:   delivery.EventID,
+		EventType: delivery.EventType.String(),
+		Body:      delivery.Payload,
+	})
+	if err == nil {
+		return d.storage.RecordWebhookAttempt(ctx, delivery.ID, domain.WebhookDeliverySucceeded, statusCode, "", time.Now())
+	}
+
+	maxAttempts := d.cfg.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	attempts := delivery.Attempts + 1
+	status := domain.WebhookDeliveryPending
+	if attempts >= maxAttempts && !errors.Is(err, context.Canceled) {
+		status = domain.WebhookDeliveryFailed
+	}
+
+	d.logger.Warn(
+		"webhook delivery failed",
+		zap.Error(err),
+		zap.Int64("delivery_id", delivery.ID),
+		zap.String("subscription_id", delivery.SubscriptionID),
+		zap.Int("attempts", attempts),
+		zap.String("status", status.String()),
+	)
+
+	return d.storage.RecordWebhookAttempt(ctx, delivery.ID, status, statusCode, err.Error(), time.Now().Add(d.backoff(delivery.Attempts)))
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	maxBackoff := d.cfg.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	delay := baseBackoff
+	for i := 0; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,208 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	webhookintegration "DobrikaDev/task-service/internal/integration/webhook"
+	"DobrikaDev/task-service/utils/config"
+)
+
+// memoryStorage keeps deliveries in memory and applies the same state
+// changes as the SQL storage.
+type memoryStorage struct {
+	mu         sync.Mutex
+	deliveries map[int64]*domain.WebhookDelivery
+}
+
+func (s *memoryStorage) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *memoryStorage) LockDueWebhookDeliveries(_ context.Context, limit int) ([]*domain.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*domain.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if len(due) < limit && delivery.Status == domain.WebhookDeliveryPending && !delivery.NextAttemptAt.After(time.Now()) {
+			copied := *delivery
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryStorage) ClaimWebhookDeliveries(_ context.Context, ids []int64, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		s.deliveries[id].NextAttemptAt = until
+	}
+	return nil
+}
+
+func (s *memoryStorage) RecordWebhookAttempt(
+	_ context.Context,
+	id int64,
+	status domain.WebhookDeliveryStatus,
+	statusCode int,
+	cause string,
+	nextAttemptAt time.Time,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivery := s.deliveries[id]
+	delivery.Status = status
+	delivery.Attempts++
+	delivery.LastStatusCode = &statusCode
+	delivery.LastError = &cause
+	delivery.NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+// reset mirrors ResetWebhookDelivery, which backs manual redelivery.
+func (s *memoryStorage) reset(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivery := s.deliveries[id]
+	delivery.Status = domain.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+}
+
+// makeDue lets the next batch pick the delivery up without waiting for its
+// backoff.
+func (s *memoryStorage) makeDue(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveries[id].NextAttemptAt = time.Now()
+}
+
+func (s *memoryStorage) get(id int64) domain.WebhookDelivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.deliveries[id]
+}
+
+// newTestDispatcher returns a dispatcher sending to a receiver that answers
+// with the given status codes in turn, and the number of requests received.
+func newTestDispatcher(t *testing.T, cfg config.WebhookConfig, codes ...int) (*Dispatcher, *memoryStorage, func() int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Header.Get(webhookintegration.SignatureHeader) == "" {
+			t.Error("delivery is not signed")
+		}
+		w.WriteHeader(codes[min(requests, len(codes)-1)])
+		requests++
+	}))
+	t.Cleanup(receiver.Close)
+
+	storage := &memoryStorage{deliveries: map[int64]*domain.WebhookDelivery{
+		1: {
+			ID:            1,
+			EventID:       "event-1",
+			EventType:     domain.WebhookParticipantJoined,
+			Payload:       []byte(`{}`),
+			Status:        domain.WebhookDeliveryPending,
+			NextAttemptAt: time.Now(),
+			URL:           receiver.URL,
+			Secret:        "secret",
+		},
+	}}
+
+	dispatcher := NewDispatcher(storage, webhookintegration.NewSender(receiver.Client(), time.Second), cfg, nil)
+	dispatcher.ctx = context.Background()
+
+	return dispatcher, storage, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	dispatcher, storage, requests := newTestDispatcher(t, config.WebhookConfig{}, http.StatusInternalServerError, http.StatusOK)
+
+	before := time.Now()
+	dispatcher.dispatchBatch()
+
+	failed := storage.get(1)
+	if failed.Status != domain.WebhookDeliveryPending || failed.Attempts != 1 {
+		t.Fatalf("after failure: status %s, attempts %d; want pending, 1", failed.Status, failed.Attempts)
+	}
+	if *failed.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("last status code = %d, want 500", *failed.LastStatusCode)
+	}
+	if wait := failed.NextAttemptAt.Sub(before); wait < baseBackoff {
+		t.Fatalf("retry scheduled after %s, want at least %s", wait, baseBackoff)
+	}
+
+	// Not due yet: another batch must not send it again.
+	dispatcher.dispatchBatch()
+	if got := requests(); got != 1 {
+		t.Fatalf("requests = %d before the backoff elapsed, want 1", got)
+	}
+
+	storage.makeDue(1)
+	dispatcher.dispatchBatch()
+
+	delivered := storage.get(1)
+	if delivered.Status != domain.WebhookDeliverySucceeded || delivered.Attempts != 2 || requests() != 2 {
+		t.Fatalf("after retry: status %s, attempts %d, requests %d; want succeeded, 2, 2",
+			delivered.Status, delivered.Attempts, requests())
+	}
+}
+
+func TestDispatcherBackoffGrows(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, config.WebhookConfig{MaxBackoff: time.Minute}, nil)
+
+	if got := dispatcher.backoff(0); got != baseBackoff {
+		t.Errorf("backoff(0) = %s, want %s", got, baseBackoff)
+	}
+	if got := dispatcher.backoff(1); got != 2*baseBackoff {
+		t.Errorf("backoff(1) = %s, want %s", got, 2*baseBackoff)
+	}
+	if got := dispatcher.backoff(10); got != time.Minute {
+		t.Errorf("backoff(10) = %s, want the one minute cap", got)
+	}
+}
+
+func TestDispatcherRedeliversFailedDelivery(t *testing.T) {
+	cfg := config.WebhookConfig{MaxAttempts: 1}
+	dispatcher, storage, requests := newTestDispatcher(t, cfg, http.StatusServiceUnavailable, http.StatusOK)
+
+	dispatcher.dispatchBatch()
+	if failed := storage.get(1); failed.Status != domain.WebhookDeliveryFailed {
+		t.Fatalf("status after the last attempt = %s, want failed", failed.Status)
+	}
+
+	// Failed deliveries stay put until they are redelivered by hand.
+	storage.makeDue(1)
+	dispatcher.dispatchBatch()
+	if got := requests(); got != 1 {
+		t.Fatalf("requests = %d for a failed delivery, want 1", got)
+	}
+
+	storage.reset(1)
+	dispatcher.dispatchBatch()
+
+	delivered := storage.get(1)
+	if delivered.Status != domain.WebhookDeliverySucceeded || delivered.Attempts != 1 || requests() != 2 {
+		t.Fatalf("after redelivery: status %s, attempts %d, requests %d; want succeeded, 1, 2",
+			delivered.Status, delivered.Attempts, requests())
+	}
+}
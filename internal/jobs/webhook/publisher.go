@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	eventspb "DobrikaDev/task-service/internal/generated/proto/events"
+	"DobrikaDev/task-service/internal/jobs/outbox"
+)
+
+type PublisherStorage interface {
+	GetWebhookSubscriptions(ctx context.Context, customerID string, activeOnly bool) ([]*domain.WebhookSubscription, error)
+	EnqueueWebhookDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error
+}
+
+// Publisher is an outbox.EventPublisher that turns participation events into
+// pending deliveries for every matching subscription of the task's customer.
+// Sending happens later in the Dispatcher.
+type Publisher struct {
+	storage PublisherStorage
+}
+
+func NewPublisher(storage PublisherStorage) *Publisher {
+	return &Publisher{storage: storage}
+}
+
+// Body is the JSON document posted to subscribers.
+type Body struct {
+	ID         string                  `json:"id"`
+	Type       domain.WebhookEventType `json:"type"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Data       BodyData                `json:"data"`
+}
+
+type BodyData struct {
+	TaskID     string `json:"task_id"`
+	CustomerID string `json:"customer_id"`
+	UserID     string `json:"user_id"`
+	Status     string `json:"status"`
+}
+
+func (p *Publisher) Publish(ctx context.Context, message outbox.Message) error {
+	if message.Type != domain.EventUserTaskCreated && message.Type != domain.EventUserTaskStatusChanged {
+		return nil
+	}
+
+	envelope, err := message.Envelope()
+	if err != nil {
+		return err
+	}
+
+	body, ok := bodyFromEnvelope(envelope)
+	if !ok || body.Data.CustomerID == "" {
+		return nil
+	}
+
+	subscriptions, err := p.storage.GetWebhookSubscriptions(ctx, body.Data.CustomerID, true)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("webhook publisher: encode body: %w", err)
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.Accepts(body.Type) {
+			continue
+		}
+		deliveries = append(deliveries, &domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        body.ID,
+			EventType:      body.Type,
+			Payload:        payload,
+		})
+	}
+
+	return p.storage.EnqueueWebhookDeliveries(ctx, deliveries)
+}
+
+func bodyFromEnvelope(envelope *eventspb.Envelope) (Body, bool) {
+	body := Body{
+		ID:         envelope.GetId(),
+		OccurredAt: time.Unix(envelope.GetOccurredAt(), 0).UTC(),
+	}
+
+	var status domain.Status
+	switch payload := envelope.GetPayload().(type) {
+	case *eventspb.Envelope_UserTaskCreated:
+		status = domain.Status(payload.UserTaskCreated.GetStatus())
+		body.Data = BodyData{
+			TaskID:     payload.UserTaskCreated.GetTaskId(),
+			CustomerID: payload.UserTaskCreated.GetCustomerId(),
+			UserID:     payload.UserTaskCreated.GetUserId(),
+		}
+		body.Type = domain.WebhookParticipantJoined
+	case *eventspb.Envelope_UserTaskStatusChanged:
+		status = domain.Status(payload.UserTaskStatusChanged.GetStatus())
+		body.Data = BodyData{
+			TaskID:     payload.UserTaskStatusChanged.GetTaskId(),
+			CustomerID: payload.UserTaskStatusChanged.GetCustomerId(),
+			UserID:     payload.UserTaskStatusChanged.GetUserId(),
+		}
+		eventType, ok := domain.WebhookEventTypeForStatus(status)
+		if !ok {
+			return Body{}, false
+		}
+		body.Type = eventType
+	default:
+		return Body{}, false
+	}
+
+	body.Data.Status = status.String()
+	return body, true
+}
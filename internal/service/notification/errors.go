@@ -0,0 +1,6 @@
+package notification
+
+import "errors"
+
+var ErrNotificationInternal = errors.New("notification internal error")
+var ErrNotificationInvalid = errors.New("notification invalid")
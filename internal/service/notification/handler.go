@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	"DobrikaDev/task-service/internal/storage/sql"
+
+	"go.uber.org/zap"
+)
+
+const minutesPerDay = 24 * 60
+
+func (s *NotificationService) ListNotifications(
+	ctx context.Context,
+	recipientID string,
+	unreadOnly bool,
+	limit, offset int,
+) (*NotificationPage, error) {
+	if recipientID == "" || limit < 0 || offset < 0 {
+		return nil, ErrNotificationInvalid
+	}
+
+	notifications, total, err := s.storage.GetNotifications(ctx, recipientID, unreadOnly, limit, offset)
+	if err != nil {
+		s.logger.Error("failed to get notifications", zap.Error(err), zap.String("recipient_id", recipientID))
+		return nil, ErrNotificationInternal
+	}
+
+	unread, err := s.storage.CountUnreadNotifications(ctx, recipientID)
+	if err != nil {
+		s.logger.Error("failed to count unread notifications", zap.Error(err), zap.String("recipient_id", recipientID))
+		return nil, ErrNotificationInternal
+	}
+
+	return &NotificationPage{
+		Notifications: notifications,
+		Total:         total,
+		Unread:        unread,
+	}, nil
+}
+
+// MarkRead marks the given notifications as read, or the whole inbox when ids
+// is empty, and returns how many were unread before.
+func (s *NotificationService) MarkRead(ctx context.Context, recipientID string, ids []string) (int, error) {
+	if recipientID == "" {
+		return 0, ErrNotificationInvalid
+	}
+
+	updated, err := s.storage.MarkNotificationsRead(ctx, recipientID, ids)
+	if err != nil {
+		s.logger.Error("failed to mark notifications read", zap.Error(err), zap.String("recipient_id", recipientID))
+		return 0, ErrNotificationInternal
+	}
+
+	return updated, nil
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	if userID == "" {
+		return nil, ErrNotificationInvalid
+	}
+
+	preferences, err := s.storage.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNotificationPreferencesNotFound) {
+			return domain.DefaultNotificationPreferences(userID), nil
+		}
+		s.logger.Error("failed to get notification preferences", zap.Error(err), zap.String("user_id", userID))
+		return nil, ErrNotificationInternal
+	}
+
+	return preferences, nil
+}
+
+func (s *NotificationService) UpdatePreferences(
+	ctx context.Context,
+	preferences *domain.NotificationPreferences,
+) (*domain.NotificationPreferences, error) {
+	if preferences.UserID == "" {
+		return nil, ErrNotificationInvalid
+	}
+	for _, channel := range preferences.ChannelList() {
+		if !channel.Valid() {
+			return nil, ErrNotificationInvalid
+		}
+	}
+	if (preferences.QuietHoursStart == nil) != (preferences.QuietHoursEnd == nil) {
+		return nil, ErrNotificationInvalid
+	}
+	if preferences.QuietHoursStart != nil && (!validMinute(*preferences.QuietHoursStart) || !validMinute(*preferences.QuietHoursEnd)) {
+		return nil, ErrNotificationInvalid
+	}
+	if preferences.Timezone == "" {
+		preferences.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(preferences.Timezone); err != nil {
+		return nil, ErrNotificationInvalid
+	}
+
+	saved, err := s.storage.SaveNotificationPreferences(ctx, preferences)
+	if err != nil {
+		s.logger.Error("failed to save notification preferences", zap.Error(err), zap.String("user_id", preferences.UserID))
+		return nil, ErrNotificationInternal
+	}
+
+	return saved, nil
+}
+
+func validMinute(minute int) bool {
+	return minute >= 0 && minute < minutesPerDay
+}
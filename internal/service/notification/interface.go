@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"context"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+type storage interface {
+	GetNotifications(ctx context.Context, recipientID string, unreadOnly bool, limit, offset int) ([]*domain.Notification, int, error)
+	CountUnreadNotifications(ctx context.Context, recipientID string) (int, error)
+	MarkNotificationsRead(ctx context.Context, recipientID string, ids []string) (int, error)
+
+	GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error)
+	SaveNotificationPreferences(ctx context.Context, preferences *domain.NotificationPreferences) (*domain.NotificationPreferences, error)
+}
+
+type NotificationService struct {
+	storage storage
+	logger  *zap.Logger
+}
+
+func NewNotificationService(storage storage, logger *zap.Logger) *NotificationService {
+	return &NotificationService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+type NotificationPage struct {
+	Notifications []*domain.Notification
+	Total         int
+	Unread        int
+}
@@ -0,0 +1,7 @@
+package searchindex
+
+import "errors"
+
+var ErrReindexInvalid = errors.New("reindex request invalid")
+var ErrReindexInternal = errors.New("reindex internal error")
+var ErrSearchIndexUnavailable = errors.New("search indexing is not configured")
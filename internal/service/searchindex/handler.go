@@ -0,0 +1,177 @@
+package searchindex
+
+import (
+	"context"
+	"strings"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+const (
+	maxReindexTaskIDs   = 1000
+	defaultDeadJobLimit = 50
+	maxDeadJobLimit     = 500
+)
+
+func (s *SearchIndexService) Reindex(ctx context.Context, options ReindexOptions) (*ReindexResult, error) {
+	if !s.enabled {
+		return nil, ErrSearchIndexUnavailable
+	}
+
+	taskIDs := make([]string, 0, len(options.TaskIDs))
+	for _, id := range options.TaskIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			taskIDs = append(taskIDs, id)
+		}
+	}
+	customerID := strings.TrimSpace(options.CustomerID)
+
+	selectors := 0
+	for _, set := range []bool{options.All, customerID != "", len(taskIDs) > 0} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors > 1 || (selectors == 0 && !options.ResetCursor) || len(taskIDs) > maxReindexTaskIDs {
+		return nil, ErrReindexInvalid
+	}
+
+	result := &ReindexResult{}
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if options.ResetCursor {
+			if err := s.storage.ResetSearchCursor(ctx); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case len(taskIDs) > 0:
+			// Ids are queued as given: a job for a deleted task removes it
+			// from the index.
+			if err := s.storage.EnqueueIndexJobs(ctx, taskIDs); err != nil {
+				return err
+			}
+			result.Queued = len(taskIDs)
+		case options.All || customerID != "":
+			queued, err := s.storage.EnqueueCustomerIndexJobs(ctx, customerID)
+			if err != nil {
+				return err
+			}
+			result.Queued = queued
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to queue reindex", zap.Error(err))
+		return nil, ErrReindexInternal
+	}
+
+	result.Pending, err = s.PendingJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info(
+		"reindex queued",
+		zap.Bool("all", options.All),
+		zap.String("customer_id", customerID),
+		zap.Int("task_ids", len(taskIDs)),
+		zap.Bool("reset_cursor", options.ResetCursor),
+		zap.Int("queued", result.Queued),
+	)
+
+	return result, nil
+}
+
+// PendingJobs reports how many index jobs are still waiting, which is the
+// progress of a running reindex.
+func (s *SearchIndexService) PendingJobs(ctx context.Context) (int, error) {
+	pending, err := s.storage.CountPendingIndexJobs(ctx)
+	if err != nil {
+		return 0, ErrReindexInternal
+	}
+	return pending, nil
+}
+
+// GetIndexerStatus reports how far the search index trails the tasks table.
+// The run fields are written by whichever replica leads the indexer.
+func (s *SearchIndexService) GetIndexerStatus(ctx context.Context) (*IndexerStatus, error) {
+	state, err := s.storage.GetIndexerState(ctx)
+	if err != nil {
+		return nil, ErrReindexInternal
+	}
+
+	latest, err := s.storage.GetLatestTaskUpdate(ctx)
+	if err != nil {
+		return nil, ErrReindexInternal
+	}
+
+	counts, err := s.storage.CountIndexJobsByStatus(ctx)
+	if err != nil {
+		return nil, ErrReindexInternal
+	}
+
+	status := &IndexerStatus{
+		Cursor:        state.UpdatedAt,
+		Pending:       counts[domain.IndexJobPending],
+		Dead:          counts[domain.IndexJobDead],
+		LastRunAt:     state.LastRunAt,
+		LastSuccessAt: state.LastSuccessAt,
+		LastErrorAt:   state.LastErrorAt,
+	}
+	if state.LastError != nil {
+		status.LastError = *state.LastError
+	}
+	if latest.After(state.UpdatedAt) {
+		status.Lag = latest.Sub(state.UpdatedAt)
+	}
+	if s.breaker != nil {
+		status.BreakerState = s.breaker.BreakerState().String()
+	}
+
+	return status, nil
+}
+
+// ListDeadIndexJobs returns the jobs that ran out of retries, oldest first.
+func (s *SearchIndexService) ListDeadIndexJobs(ctx context.Context, limit, offset int) ([]*domain.IndexJob, int, error) {
+	if limit < 0 || offset < 0 {
+		return nil, 0, ErrReindexInvalid
+	}
+	if limit == 0 {
+		limit = defaultDeadJobLimit
+	}
+	if limit > maxDeadJobLimit {
+		limit = maxDeadJobLimit
+	}
+
+	jobs, total, err := s.storage.GetIndexJobs(ctx, domain.IndexJobDead, limit, offset)
+	if err != nil {
+		return nil, 0, ErrReindexInternal
+	}
+
+	return jobs, total, nil
+}
+
+// ReplayDeadIndexJobs queues dead jobs again with a fresh attempt budget.
+// Without ids every dead job is replayed.
+func (s *SearchIndexService) ReplayDeadIndexJobs(ctx context.Context, ids []int64) (int, error) {
+	if !s.enabled {
+		return 0, ErrSearchIndexUnavailable
+	}
+	for _, id := range ids {
+		if id <= 0 {
+			return 0, ErrReindexInvalid
+		}
+	}
+
+	replayed, err := s.storage.ReplayDeadIndexJobs(ctx, ids)
+	if err != nil {
+		return 0, ErrReindexInternal
+	}
+
+	s.logger.Info("dead index jobs replayed", zap.Int("requested", len(ids)), zap.Int("replayed", replayed))
+	return replayed, nil
+}
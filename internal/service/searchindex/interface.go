@@ -0,0 +1,100 @@
+package searchindex
+
+import (
+	"context"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	searchintegration "DobrikaDev/task-service/internal/integration/search"
+
+	"go.uber.org/zap"
+)
+
+type storage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+
+	EnqueueIndexJobs(ctx context.Context, taskIDs []string) error
+	EnqueueCustomerIndexJobs(ctx context.Context, customerID string) (int, error)
+	CountPendingIndexJobs(ctx context.Context) (int, error)
+	ResetSearchCursor(ctx context.Context) error
+
+	GetIndexerState(ctx context.Context) (*domain.IndexerState, error)
+	GetLatestTaskUpdate(ctx context.Context) (time.Time, error)
+	CountIndexJobsByStatus(ctx context.Context) (map[domain.IndexJobStatus]int, error)
+	GetIndexJobs(ctx context.Context, status domain.IndexJobStatus, limit, offset int) ([]*domain.IndexJob, int, error)
+	ReplayDeadIndexJobs(ctx context.Context, ids []int64) (int, error)
+}
+
+// breaker reports the circuit breaker state of the search client.
+type breaker interface {
+	BreakerState() searchintegration.BreakerState
+}
+
+// SearchIndexService runs administrative operations on the search index.
+// It only queues work; the indexer scheduler of the running service carries
+// it out at its configured rate.
+type SearchIndexService struct {
+	storage storage
+	enabled bool
+	logger  *zap.Logger
+	// breaker is nil when the search client has none.
+	breaker breaker
+}
+
+type Option func(*SearchIndexService)
+
+// WithBreaker makes GetIndexerStatus report the state of breaker.
+func WithBreaker(breaker breaker) Option {
+	return func(s *SearchIndexService) {
+		if breaker != nil {
+			s.breaker = breaker
+		}
+	}
+}
+
+// NewSearchIndexService creates the service. enabled tells whether a search
+// engine is configured; without one queued jobs would never be drained.
+func NewSearchIndexService(storage storage, enabled bool, logger *zap.Logger, opts ...Option) *SearchIndexService {
+	service := &SearchIndexService{
+		storage: storage,
+		enabled: enabled,
+		logger:  logger,
+	}
+	for _, opt := range opts {
+		opt(service)
+	}
+	return service
+}
+
+// ReindexOptions selects the tasks to reindex. At most one of All, CustomerID
+// and TaskIDs may be set, and one is required unless ResetCursor is.
+type ReindexOptions struct {
+	All        bool
+	CustomerID string
+	TaskIDs    []string
+	// ResetCursor makes the next sweep walk every task updated since the
+	// beginning of time.
+	ResetCursor bool
+}
+
+type ReindexResult struct {
+	Queued int
+	// Pending is the number of jobs waiting in the queue after this request,
+	// including jobs queued earlier.
+	Pending int
+}
+
+type IndexerStatus struct {
+	Cursor time.Time
+	// Lag is how far the cursor trails the newest task update.
+	Lag           time.Duration
+	Pending       int
+	Dead          int
+	LastRunAt     *time.Time
+	LastSuccessAt *time.Time
+	LastError     string
+	LastErrorAt   *time.Time
+	// BreakerState is the search client's circuit breaker state on this
+	// replica, empty when it has none.
+	BreakerState string
+}
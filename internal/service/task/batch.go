@@ -0,0 +1,114 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	"DobrikaDev/task-service/internal/broker"
+	"DobrikaDev/task-service/internal/domain"
+)
+
+// BatchMode controls how a batch reacts to a failing item.
+type BatchMode int
+
+const (
+	// BatchModeAtomic rolls back the whole batch when any item fails.
+	BatchModeAtomic BatchMode = iota
+	// BatchModePartial commits the items that succeeded and reports errors per item.
+	BatchModePartial
+)
+
+type BatchResult struct {
+	Task *domain.Task
+	Err  error
+}
+
+func (s *TaskService) BatchGetTasks(ctx context.Context, ids []string) ([]*domain.Task, error) {
+	tasks, err := s.storage.GetTasksByIDs(ctx, ids)
+	if err != nil {
+		return nil, ErrTaskInternal
+	}
+
+	taskByID := make(map[string]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		if task != nil && task.ID != "" {
+			taskByID[task.ID] = task
+		}
+	}
+
+	result := make([]*domain.Task, 0, len(ids))
+	for _, id := range ids {
+		if task, ok := taskByID[id]; ok {
+			result = append(result, task)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *TaskService) BatchCreateTasks(ctx context.Context, tasks []*domain.Task, mode BatchMode) ([]BatchResult, error) {
+	return s.runBatch(ctx, tasks, mode, s.createTask, broker.EventTaskCreated)
+}
+
+func (s *TaskService) BatchUpdateTasks(ctx context.Context, tasks []*domain.Task, mode BatchMode) ([]BatchResult, error) {
+	return s.runBatch(ctx, tasks, mode, s.updateTask, broker.EventTaskUpdated)
+}
+
+// runBatch applies fn to every task inside one transaction. In partial mode
+// each item runs in its own savepoint so that a failing statement does not
+// abort the surrounding transaction.
+func (s *TaskService) runBatch(
+	ctx context.Context,
+	tasks []*domain.Task,
+	mode BatchMode,
+	fn func(context.Context, *domain.Task) (*domain.Task, error),
+	eventType broker.EventType,
+) ([]BatchResult, error) {
+	results := make([]BatchResult, len(tasks))
+
+	var itemErr error
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		for i, task := range tasks {
+			if mode == BatchModePartial {
+				var saved *domain.Task
+				err := s.storage.DoNested(ctx, func(ctx context.Context) error {
+					var err error
+					saved, err = fn(ctx, task)
+					return err
+				})
+				results[i] = BatchResult{Task: saved, Err: err}
+				continue
+			}
+
+			saved, err := fn(ctx, task)
+			if err != nil {
+				results[i] = BatchResult{Err: err}
+				itemErr = err
+				return err
+			}
+			results[i] = BatchResult{Task: saved}
+		}
+		return nil
+	})
+	if err != nil {
+		if itemErr != nil && errors.Is(err, itemErr) {
+			for i := range results {
+				results[i].Task = nil
+			}
+			return results, itemErr
+		}
+		return nil, ErrTaskInternal
+	}
+
+	for _, result := range results {
+		if result.Err != nil || result.Task == nil {
+			continue
+		}
+		if s.indexer != nil {
+			s.indexer.NotifyTaskChanged(result.Task.ID)
+		}
+		s.publishTaskEvent(ctx, eventType, result.Task)
+	}
+
+	return results, nil
+}
@@ -12,3 +12,7 @@ var ErrUserTaskAlreadyExists = errors.New("user task already exists")
 var ErrUserTaskInternal = errors.New("user task internal error")
 var ErrUserTaskInvalid = errors.New("user task invalid")
 var ErrUserTaskNotFound = errors.New("user task not found")
+
+var ErrWatchUnavailable = errors.New("watch unavailable")
+var ErrWatchEventExpired = errors.New("watch event id expired")
+var ErrWatchEventInvalid = errors.New("watch event id invalid")
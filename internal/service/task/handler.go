@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
+	"DobrikaDev/task-service/internal/broker"
 	"DobrikaDev/task-service/internal/domain"
 	searchintegration "DobrikaDev/task-service/internal/integration/search"
 	"DobrikaDev/task-service/internal/storage/sql"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -24,17 +27,71 @@ func (s *TaskService) GetTaskByID(ctx context.Context, id string) (*domain.Task,
 	return task, nil
 }
 
-func (s *TaskService) GetTasks(ctx context.Context, customerID string, limit int, offset int) ([]*domain.Task, int, error) {
+func (s *TaskService) GetTasks(ctx context.Context, options GetTasksOptions) (*TaskPage, error) {
+	filter := options.Filter
+	if filter.CostMin != nil && filter.CostMax != nil && *filter.CostMin > *filter.CostMax {
+		return nil, ErrTaskInvalid
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return nil, ErrTaskInvalid
+	}
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && filter.UpdatedFrom.After(filter.UpdatedTo) {
+		return nil, ErrTaskInvalid
+	}
+
+	sortField := options.SortField
+	if sortField == "" {
+		sortField = sql.TaskSortCreatedAt
+	}
+
+	var cursor *sql.TaskCursor
+	if options.PageToken != "" {
+		if options.Offset > 0 {
+			return nil, ErrTaskInvalid
+		}
+		var err error
+		cursor, err = decodePageToken(options.PageToken, sortField, options.SortDesc, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// One extra row tells whether another page exists without a COUNT query.
+	limit := options.Limit
+	if limit > 0 {
+		limit++
+	}
+
 	opts := []sql.GetTasksOption{
 		sql.WithTaskLimit(limit),
-		sql.WithTaskOffset(offset),
-		sql.WithTaskCustomerID(customerID),
+		sql.WithTaskOffset(options.Offset),
+		sql.WithTaskIDs(filter.IDs),
+		sql.WithTaskCustomerIDs(filter.CustomerIDs),
+		sql.WithTaskName(filter.Name),
+		sql.WithTaskNameLike(filter.NameLike),
+		sql.WithTaskVerificationTypes(filter.VerificationTypes),
+		sql.WithTaskCostRange(filter.CostMin, filter.CostMax),
+		sql.WithTaskCreatedBetween(filter.CreatedFrom, filter.CreatedTo),
+		sql.WithTaskUpdatedBetween(filter.UpdatedFrom, filter.UpdatedTo),
+		sql.WithTaskSort(sortField, options.SortDesc),
+		sql.WithTaskAfter(cursor),
 	}
+	if options.SkipTotal {
+		opts = append(opts, sql.WithoutTaskCount())
+	}
+
 	tasks, count, err := s.storage.GetTasks(ctx, opts...)
 	if err != nil {
-		return nil, 0, ErrTaskInternal
+		return nil, ErrTaskInternal
 	}
-	return tasks, count, nil
+
+	page := &TaskPage{Tasks: tasks, Total: count}
+	if options.Limit > 0 && len(tasks) > options.Limit {
+		page.Tasks = tasks[:options.Limit]
+		page.NextPageToken = encodePageToken(sortField, options.SortDesc, filter, page.Tasks[len(page.Tasks)-1])
+	}
+
+	return page, nil
 }
 
 func (s *TaskService) CountTasks(ctx context.Context, opts ...sql.GetTasksOption) (int, error) {
@@ -46,39 +103,99 @@ func (s *TaskService) CountTasks(ctx context.Context, opts ...sql.GetTasksOption
 }
 
 func (s *TaskService) CreateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	task, err := s.storage.CreateTask(ctx, task)
+	created, err := s.createTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	if s.indexer != nil {
+		s.indexer.NotifyTaskChanged(created.ID)
+	}
+	s.publishTaskEvent(ctx, broker.EventTaskCreated, created)
+	return created, nil
+}
+
+func (s *TaskService) createTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	if task.ID != "" && !domain.IsValidTaskID(task.ID) {
+		return nil, ErrTaskInvalid
+	}
+
+	var created *domain.Task
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.storage.CreateTask(ctx, task)
+		if err != nil {
+			return err
+		}
+		if err := s.recordIndexJob(ctx, created.ID); err != nil {
+			return err
+		}
+		return s.recordTaskCreated(ctx, created)
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrTaskAlreadyExists) {
 			return nil, ErrTaskAlreadyExists
 		}
+		if errors.Is(err, sql.ErrTaskInvalid) {
+			return nil, ErrTaskInvalid
+		}
+		if errors.Is(err, ErrTaskInternal) {
+			return nil, ErrTaskInternal
+		}
 		s.logger.Error("failed to create task", zap.Error(err), zap.Any("task", task))
 		return nil, ErrTaskInternal
 	}
+	return created, nil
+}
+
+func (s *TaskService) UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	updated, err := s.updateTask(ctx, task)
+	if err != nil {
+		return nil, err
+	}
 	if s.indexer != nil {
-		s.indexer.NotifyTaskChanged(task.ID)
+		s.indexer.NotifyTaskChanged(updated.ID)
 	}
-	return task, nil
+	s.publishTaskEvent(ctx, broker.EventTaskUpdated, updated)
+	return updated, nil
 }
 
-func (s *TaskService) UpdateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	task, err := s.storage.UpdateTask(ctx, task)
+func (s *TaskService) updateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	var updated *domain.Task
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.storage.UpdateTask(ctx, task)
+		if err != nil {
+			return err
+		}
+		if err := s.recordIndexJob(ctx, updated.ID); err != nil {
+			return err
+		}
+		return s.recordTaskUpdated(ctx, updated)
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrTaskNotFound) {
 			return nil, ErrTaskNotFound
 		}
+		if errors.Is(err, sql.ErrTaskInvalid) {
+			return nil, ErrTaskInvalid
+		}
+		if errors.Is(err, ErrTaskInternal) {
+			return nil, ErrTaskInternal
+		}
 		s.logger.Error("failed to update task", zap.Error(err), zap.Any("task", task))
 		return nil, ErrTaskInternal
 	}
-	if s.indexer != nil {
-		s.indexer.NotifyTaskChanged(task.ID)
-	}
-	return task, nil
+	return updated, nil
 }
 
-func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) ([]*domain.Task, error) {
-	if strings.TrimSpace(opts.Query) == "" {
+func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) (*SearchPage, error) {
+	if strings.TrimSpace(opts.Query) == "" || opts.Limit < 0 || opts.Offset < 0 || opts.Limit > maxSearchLimit {
 		return nil, ErrTaskInvalid
 	}
+	if !opts.Filter.valid() {
+		return nil, ErrTaskInvalid
+	}
+	started := time.Now()
 	if s.search == nil {
 		return nil, ErrTaskSearchUnavailable
 	}
@@ -88,6 +205,9 @@ func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) ([]*d
 		GeoData:   strings.TrimSpace(opts.GeoData),
 		QueryType: strings.TrimSpace(opts.QueryType),
 		UserTags:  make([]string, 0, len(opts.Tags)),
+		Offset:    opts.Offset,
+		Limit:     opts.Limit,
+		Filter:    opts.Filter.toSearch(),
 	}
 
 	for _, tag := range opts.Tags {
@@ -97,17 +217,86 @@ func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) ([]*d
 		}
 	}
 
+	if req.Limit == 0 {
+		req.Limit = defaultSearchLimit
+	}
+	// A continued search keeps the analytics id of its first page; only its
+	// result ids are logged.
+	searchID, logSearch := "", false
+	if opts.PageToken != "" {
+		if opts.Offset > 0 {
+			return nil, ErrTaskInvalid
+		}
+		token, err := decodeSearchPageToken(opts.PageToken, req)
+		if err != nil {
+			return nil, err
+		}
+		req.Offset = token.Offset
+		searchID = token.SearchID
+	}
+	if searchID == "" && s.recorder != nil {
+		searchID, logSearch = uuid.NewString(), true
+	}
+
+	// One extra id tells whether another page exists when the backend does
+	// not report a total.
+	pageSize := req.Limit
+	req.Limit++
+
 	resp, err := s.search.Search(ctx, req)
 	if err != nil {
 		s.logger.Error("search request failed", zap.Error(err), zap.String("query", req.UserQuery))
 		return nil, ErrTaskInternal
 	}
 
+	page := &SearchPage{Tasks: []*domain.Task{}, SearchID: searchID}
 	if resp == nil || len(resp.TaskIDs) == 0 {
-		return []*domain.Task{}, nil
+		if logSearch {
+			s.recordSearch(opts, req, page, started)
+		}
+		return page, nil
+	}
+
+	// Backends may cap the limit, e.g. full-text search at
+	// Search.FullTextLimit. When fewer ids come back than asked for while
+	// more matches exist, the page ends where the backend stopped so that
+	// the next page does not skip results.
+	if len(resp.TaskIDs) < req.Limit && resp.Total > req.Offset+len(resp.TaskIDs) {
+		pageSize = len(resp.TaskIDs)
+	}
+
+	ids := resp.TaskIDs
+	if len(ids) > pageSize {
+		ids = ids[:pageSize]
+	}
+	if len(resp.TaskIDs) > pageSize || resp.Total > req.Offset+pageSize {
+		page.NextPageToken = encodeSearchPageToken(req, req.Offset+pageSize, searchID)
 	}
+	page.Total = resp.Total
 
-	tasks, err := s.storage.GetTasksByIDs(ctx, resp.TaskIDs)
+	page.Tasks, err = s.hydrateTasks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// The backend counted matches without the filters it could not apply.
+	if unapplied := req.Filter.Unapplied(resp.AppliedFilters); len(unapplied) > 0 {
+		page.Tasks = opts.Filter.apply(page.Tasks, unapplied)
+		page.Total = 0
+	}
+
+	if logSearch {
+		s.recordSearch(opts, req, page, started)
+	} else {
+		s.recordSearchPage(page)
+	}
+	return page, nil
+}
+
+// hydrateTasks loads tasks in the order of ids, which is the search ranking,
+// and skips ids that no longer exist.
+func (s *TaskService) hydrateTasks(ctx context.Context, ids []string) ([]*domain.Task, error) {
+	tasks, err := s.storage.GetTasksByIDs(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +308,8 @@ func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) ([]*d
 		}
 	}
 
-	result := make([]*domain.Task, 0, len(resp.TaskIDs))
-	for _, id := range resp.TaskIDs {
+	result := make([]*domain.Task, 0, len(ids))
+	for _, id := range ids {
 		if task, ok := taskByID[id]; ok {
 			result = append(result, task)
 		}
@@ -130,7 +319,15 @@ func (s *TaskService) SearchTasks(ctx context.Context, opts SearchOptions) ([]*d
 }
 
 func (s *TaskService) DeleteTask(ctx context.Context, id string) error {
-	err := s.storage.DeleteTask(ctx, id)
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if err := s.storage.DeleteTask(ctx, id); err != nil {
+			return err
+		}
+		if err := s.recordIndexJob(ctx, id); err != nil {
+			return err
+		}
+		return s.recordTaskDeleted(ctx, id)
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrTaskNotFound) {
 			return ErrTaskNotFound
@@ -138,10 +335,16 @@ func (s *TaskService) DeleteTask(ctx context.Context, id string) error {
 		s.logger.Error("failed to delete task", zap.Error(err), zap.String("id", id))
 		return ErrTaskInternal
 	}
+	if s.indexer != nil {
+		s.indexer.NotifyTaskChanged(id)
+	}
+	s.publish(ctx, broker.Event{Type: broker.EventTaskDeleted, TaskID: id})
 	return nil
 }
 
-func (s *TaskService) UserJoinTask(ctx context.Context, userID, taskID string) (*domain.UserTask, error) {
+// UserJoinTask adds userID to the task. searchID, when set, attributes the
+// join to the search that showed the task.
+func (s *TaskService) UserJoinTask(ctx context.Context, userID, taskID, searchID string) (*domain.UserTask, error) {
 	userTask := &domain.UserTask{
 		UserID: userID,
 		TaskID: taskID,
@@ -157,7 +360,14 @@ func (s *TaskService) UserJoinTask(ctx context.Context, userID, taskID string) (
 		return nil, ErrUserTaskInvalid
 	}
 
-	userTask, err = s.storage.CreateUserTask(ctx, userTask)
+	err = s.storage.Do(ctx, func(ctx context.Context) error {
+		var err error
+		userTask, err = s.storage.CreateUserTask(ctx, userTask)
+		if err != nil {
+			return err
+		}
+		return s.recordUserTaskCreated(ctx, userTask, task.CustomerID)
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrUserTaskAlreadyExists) {
 			return nil, ErrUserTaskAlreadyExists
@@ -168,11 +378,46 @@ func (s *TaskService) UserJoinTask(ctx context.Context, userID, taskID string) (
 		s.logger.Error("failed to create user task", zap.Error(err), zap.String("user_id", userID), zap.String("task_id", taskID))
 		return nil, ErrTaskInternal
 	}
+	s.publishUserTaskEvent(ctx, broker.EventUserTaskCreated, userTask)
+	s.recordSearchJoin(searchID, userTask)
 	return userTask, nil
 }
 
 func (s *TaskService) UpdateUserTaskStatus(ctx context.Context, userID, taskID string, status domain.Status) (*domain.UserTask, error) {
-	userTask, err := s.storage.UpdateUserTaskStatus(ctx, userID, taskID, status)
+	var userTask *domain.UserTask
+	reindex := status == domain.StatusApproved
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		task, err := s.storage.GetTaskByID(ctx, taskID)
+		if err != nil {
+			if errors.Is(err, sql.ErrTaskNotFound) {
+				return sql.ErrUserTaskInvalid
+			}
+			return err
+		}
+		previous, _, err := s.storage.GetUserTasks(ctx,
+			sql.WithUserTaskUserID(userID),
+			sql.WithUserTaskTaskID(taskID),
+			sql.WithUserTaskLimit(1),
+		)
+		if err != nil {
+			return err
+		}
+		userTask, err = s.storage.UpdateUserTaskStatus(ctx, userID, taskID, status)
+		if err != nil {
+			return err
+		}
+		// Approvals can close the task, which takes it out of search, and
+		// moving a participant away from approved can reopen it.
+		if len(previous) > 0 && previous[0].Status == domain.StatusApproved {
+			reindex = true
+		}
+		if reindex {
+			if err := s.recordIndexJob(ctx, taskID); err != nil {
+				return err
+			}
+		}
+		return s.recordUserTaskStatusChanged(ctx, userTask, task.CustomerID)
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrUserTaskNotFound) {
 			return nil, ErrUserTaskNotFound
@@ -183,5 +428,72 @@ func (s *TaskService) UpdateUserTaskStatus(ctx context.Context, userID, taskID s
 		s.logger.Error("failed to update user task status", zap.Error(err), zap.String("user_id", userID), zap.String("task_id", taskID), zap.Any("status", status))
 		return nil, ErrTaskInternal
 	}
+	if s.indexer != nil && reindex {
+		s.indexer.NotifyTaskChanged(taskID)
+	}
+	s.publishUserTaskEvent(ctx, broker.EventUserTaskUpdated, userTask)
 	return userTask, nil
 }
+
+func (s *TaskService) ListUserTasks(ctx context.Context, userID string, statuses []domain.Status, limit, offset int) ([]UserTaskWithTask, int, error) {
+	if userID == "" {
+		return nil, 0, ErrUserTaskInvalid
+	}
+
+	userTasks, count, err := s.storage.GetUserTasks(ctx,
+		sql.WithUserTaskUserID(userID),
+		sql.WithUserTaskStatuses(statuses),
+		sql.WithUserTaskLimit(limit),
+		sql.WithUserTaskOffset(offset),
+	)
+	if err != nil {
+		s.logger.Error("failed to list user tasks", zap.Error(err), zap.String("user_id", userID))
+		return nil, 0, ErrUserTaskInternal
+	}
+
+	taskIDs := make([]string, 0, len(userTasks))
+	for _, userTask := range userTasks {
+		taskIDs = append(taskIDs, userTask.TaskID)
+	}
+
+	tasks, err := s.storage.GetTasksByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, 0, ErrTaskInternal
+	}
+
+	taskByID := make(map[string]*domain.Task, len(tasks))
+	for _, task := range tasks {
+		if task != nil && task.ID != "" {
+			taskByID[task.ID] = task
+		}
+	}
+
+	result := make([]UserTaskWithTask, 0, len(userTasks))
+	for _, userTask := range userTasks {
+		result = append(result, UserTaskWithTask{
+			UserTask: userTask,
+			Task:     taskByID[userTask.TaskID],
+		})
+	}
+
+	return result, count, nil
+}
+
+func (s *TaskService) ListTaskParticipants(ctx context.Context, taskID string, statuses []domain.Status, limit, offset int) ([]*domain.UserTask, int, error) {
+	if _, err := s.GetTaskByID(ctx, taskID); err != nil {
+		return nil, 0, err
+	}
+
+	userTasks, count, err := s.storage.GetUserTasks(ctx,
+		sql.WithUserTaskTaskID(taskID),
+		sql.WithUserTaskStatuses(statuses),
+		sql.WithUserTaskLimit(limit),
+		sql.WithUserTaskOffset(offset),
+	)
+	if err != nil {
+		s.logger.Error("failed to list task participants", zap.Error(err), zap.String("task_id", taskID))
+		return nil, 0, ErrUserTaskInternal
+	}
+
+	return userTasks, count, nil
+}
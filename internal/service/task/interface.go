@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"DobrikaDev/task-service/internal/broker"
 	"DobrikaDev/task-service/internal/domain"
 	searchintegration "DobrikaDev/task-service/internal/integration/search"
 	"DobrikaDev/task-service/internal/storage/sql"
@@ -13,6 +14,9 @@ import (
 )
 
 type storage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	DoNested(ctx context.Context, fn func(ctx context.Context) error) error
+
 	GetTaskByID(ctx context.Context, id string) (*domain.Task, error)
 	GetTasks(ctx context.Context, opts ...sql.GetTasksOption) ([]*domain.Task, int, error)
 	CountTasks(ctx context.Context, opts ...sql.GetTasksOption) (int, error)
@@ -22,11 +26,20 @@ type storage interface {
 
 	CreateUserTask(ctx context.Context, userTask *domain.UserTask) (*domain.UserTask, error)
 	UpdateUserTaskStatus(ctx context.Context, userID, taskID string, status domain.Status) (*domain.UserTask, error)
+	GetUserTasks(ctx context.Context, opts ...sql.GetUserTasksOption) ([]*domain.UserTask, int, error)
+
+	AddOutboxEvent(ctx context.Context, event *domain.OutboxEvent) error
+	EnqueueIndexJobs(ctx context.Context, taskIDs []string) error
+
+	GetCustomerTaskStats(ctx context.Context, customerID string, from, to time.Time) ([]*domain.TaskStats, error)
+	CountCustomerParticipants(ctx context.Context, customerID string, from, to time.Time) (int, error)
+	GetSearchTotals(ctx context.Context, from, to time.Time) (domain.SearchTotals, error)
+	GetSearchQueryStats(ctx context.Context, from, to time.Time, zeroResultsOnly bool, limit int) ([]*domain.SearchQueryStats, error)
 
 	GetTasksByIDs(ctx context.Context, ids []string) ([]*domain.Task, error)
-	GetTasksUpdatedAfter(ctx context.Context, after time.Time, limit int) ([]*domain.Task, error)
-	LoadSearchCursor(ctx context.Context) (time.Time, error)
-	SaveSearchCursor(ctx context.Context, cursor time.Time) error
+	GetTasksUpdatedAfter(ctx context.Context, after domain.SearchCursor, limit int) ([]*domain.Task, error)
+	LoadSearchCursor(ctx context.Context) (domain.SearchCursor, error)
+	SaveSearchCursor(ctx context.Context, cursor domain.SearchCursor) error
 }
 
 type indexer interface {
@@ -37,22 +50,60 @@ type searchClient interface {
 	Search(ctx context.Context, req searchintegration.SearchRequest) (*searchintegration.SearchResponse, error)
 }
 
+type searchRecorder interface {
+	RecordSearch(event *domain.SearchEvent)
+	RecordSearchPage(page *domain.SearchResultPage)
+	RecordJoin(join *domain.SearchJoin)
+}
+
+type eventBroker interface {
+	Publish(ctx context.Context, event broker.Event) error
+	Subscribe(ctx context.Context, filter broker.Filter, afterID string) (<-chan broker.Event, error)
+}
+
 type TaskService struct {
 	storage storage
 	cfg     *config.Config
 	logger  *zap.Logger
 	indexer indexer
 	search  searchClient
+	broker  eventBroker
+	// recorder is nil when search analytics are disabled.
+	recorder searchRecorder
 }
 
-func NewTaskService(storage storage, cfg *config.Config, logger *zap.Logger, indexer indexer, search searchClient) *TaskService {
-	return &TaskService{
+type Option func(*TaskService)
+
+func WithBroker(broker eventBroker) Option {
+	return func(s *TaskService) {
+		if broker != nil {
+			s.broker = broker
+		}
+	}
+}
+
+func WithSearchRecorder(recorder searchRecorder) Option {
+	return func(s *TaskService) {
+		if recorder != nil {
+			s.recorder = recorder
+		}
+	}
+}
+
+func NewTaskService(storage storage, cfg *config.Config, logger *zap.Logger, indexer indexer, search searchClient, opts ...Option) *TaskService {
+	service := &TaskService{
 		storage: storage,
 		cfg:     cfg,
 		logger:  logger,
 		indexer: indexer,
 		search:  search,
 	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	return service
 }
 
 type SearchOptions struct {
@@ -60,4 +111,75 @@ type SearchOptions struct {
 	QueryType string
 	GeoData   string
 	Tags      []string
+	Limit     int
+	Offset    int
+	// PageToken continues from a previous SearchPage.NextPageToken and must
+	// be used with the same query; it cannot be combined with Offset.
+	PageToken string
+	Filter    SearchFilter
+	// UserID is the searching user, kept for search analytics only.
+	UserID string
+}
+
+// SearchFilter narrows search results by task attributes. The search backend
+// applies what it supports and SearchTasks applies the rest to each page.
+type SearchFilter struct {
+	CustomerIDs       []string                  `json:"customer_ids,omitempty"`
+	VerificationTypes []domain.VerificationType `json:"verification_types,omitempty"`
+	CostMin           *int                      `json:"cost_min,omitempty"`
+	CostMax           *int                      `json:"cost_max,omitempty"`
+	// MinOpenSlots keeps tasks with at least this many member slots not taken
+	// by approved participants; tasks without a member limit always match.
+	MinOpenSlots int       `json:"min_open_slots,omitempty"`
+	CreatedFrom  time.Time `json:"created_from,omitzero"`
+	CreatedTo    time.Time `json:"created_to,omitzero"`
+	UpdatedFrom  time.Time `json:"updated_from,omitzero"`
+	UpdatedTo    time.Time `json:"updated_to,omitzero"`
+}
+
+type SearchPage struct {
+	Tasks []*domain.Task
+	// Total is 0 when the search backend does not report it or some filters
+	// were applied after the search.
+	Total         int
+	NextPageToken string
+	// SearchID identifies the search for UserJoinTask attribution; pages
+	// continued with NextPageToken keep the id of the first page. It is empty
+	// when search analytics are disabled.
+	SearchID string
+}
+
+type TaskFilter struct {
+	IDs               []string
+	CustomerIDs       []string
+	Name              string
+	NameLike          string
+	VerificationTypes []domain.VerificationType
+	CostMin           *int
+	CostMax           *int
+	CreatedFrom       time.Time
+	CreatedTo         time.Time
+	UpdatedFrom       time.Time
+	UpdatedTo         time.Time
+}
+
+type GetTasksOptions struct {
+	Filter    TaskFilter
+	SortField sql.TaskSortField
+	SortDesc  bool
+	Limit     int
+	Offset    int
+	PageToken string
+	SkipTotal bool
+}
+
+type TaskPage struct {
+	Tasks         []*domain.Task
+	Total         int
+	NextPageToken string
+}
+
+type UserTaskWithTask struct {
+	UserTask *domain.UserTask
+	Task     *domain.Task
 }
@@ -0,0 +1,142 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	eventspb "DobrikaDev/task-service/internal/generated/proto/events"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventSchemaVersion is the version of proto/events/events.proto written to
+// new outbox rows. Bump it together with breaking payload changes.
+const eventSchemaVersion = 1
+
+// recordEvent stores envelope in the outbox. Callers run it inside the
+// transaction of the change it describes.
+func (s *TaskService) recordEvent(ctx context.Context, envelope *eventspb.Envelope) error {
+	envelope.Id = uuid.NewString()
+	envelope.SchemaVersion = eventSchemaVersion
+	envelope.OccurredAt = time.Now().UTC().Unix()
+
+	payload, err := proto.Marshal(envelope)
+	if err != nil {
+		s.logger.Error("failed to encode outbox event", zap.Error(err), zap.String("event_type", envelope.Type))
+		return ErrTaskInternal
+	}
+
+	err = s.storage.AddOutboxEvent(ctx, &domain.OutboxEvent{
+		EventID:       envelope.Id,
+		EventType:     domain.EventType(envelope.Type),
+		AggregateID:   envelope.AggregateId,
+		SchemaVersion: eventSchemaVersion,
+		Payload:       payload,
+	})
+	if err != nil {
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+// recordIndexJob queues taskID for the search indexer in the transaction of
+// the change. Nothing is queued when search indexing is not configured.
+func (s *TaskService) recordIndexJob(ctx context.Context, taskID string) error {
+	if s.indexer == nil {
+		return nil
+	}
+	if err := s.storage.EnqueueIndexJobs(ctx, []string{taskID}); err != nil {
+		return ErrTaskInternal
+	}
+	return nil
+}
+
+func (s *TaskService) recordTaskCreated(ctx context.Context, task *domain.Task) error {
+	return s.recordEvent(ctx, &eventspb.Envelope{
+		Type:        domain.EventTaskCreated.String(),
+		AggregateId: task.ID,
+		Payload: &eventspb.Envelope_TaskCreated{
+			TaskCreated: &eventspb.TaskCreated{Task: taskSnapshot(task)},
+		},
+	})
+}
+
+func (s *TaskService) recordTaskUpdated(ctx context.Context, task *domain.Task) error {
+	return s.recordEvent(ctx, &eventspb.Envelope{
+		Type:        domain.EventTaskUpdated.String(),
+		AggregateId: task.ID,
+		Payload: &eventspb.Envelope_TaskUpdated{
+			TaskUpdated: &eventspb.TaskUpdated{Task: taskSnapshot(task)},
+		},
+	})
+}
+
+func (s *TaskService) recordTaskDeleted(ctx context.Context, taskID string) error {
+	return s.recordEvent(ctx, &eventspb.Envelope{
+		Type:        domain.EventTaskDeleted.String(),
+		AggregateId: taskID,
+		Payload: &eventspb.Envelope_TaskDeleted{
+			TaskDeleted: &eventspb.TaskDeleted{TaskId: taskID},
+		},
+	})
+}
+
+func (s *TaskService) recordUserTaskCreated(ctx context.Context, userTask *domain.UserTask, customerID string) error {
+	return s.recordEvent(ctx, &eventspb.Envelope{
+		Type:        domain.EventUserTaskCreated.String(),
+		AggregateId: userTask.TaskID,
+		Payload: &eventspb.Envelope_UserTaskCreated{
+			UserTaskCreated: &eventspb.UserTaskCreated{
+				UserId:     userTask.UserID,
+				TaskId:     userTask.TaskID,
+				CustomerId: customerID,
+				Status:     userTask.Status.String(),
+				CreatedAt:  userTask.CreatedAt.Unix(),
+			},
+		},
+	})
+}
+
+func (s *TaskService) recordUserTaskStatusChanged(ctx context.Context, userTask *domain.UserTask, customerID string) error {
+	return s.recordEvent(ctx, &eventspb.Envelope{
+		Type:        domain.EventUserTaskStatusChanged.String(),
+		AggregateId: userTask.TaskID,
+		Payload: &eventspb.Envelope_UserTaskStatusChanged{
+			UserTaskStatusChanged: &eventspb.UserTaskStatusChanged{
+				UserId:     userTask.UserID,
+				TaskId:     userTask.TaskID,
+				CustomerId: customerID,
+				Status:     userTask.Status.String(),
+				ChangedAt:  userTask.UpdatedAt.Unix(),
+			},
+		},
+	})
+}
+
+func taskSnapshot(task *domain.Task) *eventspb.TaskSnapshot {
+	snapshot := &eventspb.TaskSnapshot{
+		Id:               task.ID,
+		CustomerId:       task.CustomerID,
+		Name:             task.Name,
+		Description:      task.Description,
+		VerificationType: task.VerificationType.String(),
+		Cost:             int32(task.Cost),
+		MembersCount:     int32(task.MembersCount),
+		CreatedAt:        task.CreatedAt.Unix(),
+		UpdatedAt:        task.UpdatedAt.Unix(),
+	}
+
+	if len(task.Meta) > 0 {
+		var meta map[string]string
+		if err := json.Unmarshal(task.Meta, &meta); err == nil && len(meta) > 0 {
+			snapshot.Meta = meta
+		}
+	}
+
+	return snapshot
+}
@@ -0,0 +1,185 @@
+package task
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	searchintegration "DobrikaDev/task-service/internal/integration/search"
+	"DobrikaDev/task-service/internal/storage/sql"
+)
+
+// pageToken is the decoded form of the opaque next_page_token. It records the
+// sort and a fingerprint of the filter it was issued for so that a token
+// cannot be replayed against another ordering or another result set.
+type pageToken struct {
+	Field  sql.TaskSortField `json:"f"`
+	Desc   bool              `json:"d"`
+	Filter string            `json:"h"`
+	Value  string            `json:"v"`
+	ID     string            `json:"id"`
+}
+
+// filterFingerprint hashes filter independently of the order of its list
+// values.
+func filterFingerprint(filter TaskFilter) string {
+	ids := slices.Sorted(slices.Values(filter.IDs))
+	customerIDs := slices.Sorted(slices.Values(filter.CustomerIDs))
+	verificationTypes := make([]string, 0, len(filter.VerificationTypes))
+	for _, verificationType := range filter.VerificationTypes {
+		verificationTypes = append(verificationTypes, string(verificationType))
+	}
+	slices.Sort(verificationTypes)
+
+	optionalInt := func(value *int) string {
+		if value == nil {
+			return ""
+		}
+		return strconv.Itoa(*value)
+	}
+	unixNano := func(value time.Time) string {
+		if value.IsZero() {
+			return ""
+		}
+		return strconv.FormatInt(value.UnixNano(), 10)
+	}
+
+	parts := []string{
+		strings.Join(ids, ","),
+		strings.Join(customerIDs, ","),
+		filter.Name,
+		filter.NameLike,
+		strings.Join(verificationTypes, ","),
+		optionalInt(filter.CostMin),
+		optionalInt(filter.CostMax),
+		unixNano(filter.CreatedFrom),
+		unixNano(filter.CreatedTo),
+		unixNano(filter.UpdatedFrom),
+		unixNano(filter.UpdatedTo),
+	}
+
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil)[:12])
+}
+
+func encodePageToken(field sql.TaskSortField, desc bool, filter TaskFilter, last *domain.Task) string {
+	token := pageToken{Field: field, Desc: desc, Filter: filterFingerprint(filter), ID: last.ID}
+
+	switch field {
+	case sql.TaskSortUpdatedAt:
+		token.Value = last.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case sql.TaskSortCost:
+		token.Value = strconv.Itoa(last.Cost)
+	case sql.TaskSortName:
+		token.Value = last.Name
+	default:
+		token.Value = last.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(raw string, field sql.TaskSortField, desc bool, filter TaskFilter) (*sql.TaskCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrTaskInvalid
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, ErrTaskInvalid
+	}
+
+	if token.Field != field || token.Desc != desc || token.Filter != filterFingerprint(filter) || token.ID == "" {
+		return nil, ErrTaskInvalid
+	}
+
+	cursor := &sql.TaskCursor{Field: token.Field, Desc: token.Desc, ID: token.ID}
+
+	switch token.Field {
+	case sql.TaskSortCreatedAt, sql.TaskSortUpdatedAt:
+		value, err := time.Parse(time.RFC3339Nano, token.Value)
+		if err != nil {
+			return nil, ErrTaskInvalid
+		}
+		cursor.Value = value
+	case sql.TaskSortCost:
+		value, err := strconv.Atoi(token.Value)
+		if err != nil {
+			return nil, ErrTaskInvalid
+		}
+		cursor.Value = value
+	case sql.TaskSortName:
+		cursor.Value = token.Value
+	default:
+		return nil, ErrTaskInvalid
+	}
+
+	return cursor, nil
+}
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// searchPageToken is the decoded form of a search next_page_token. Search
+// results are ranked by the backend, so the token carries the next offset and
+// a fingerprint of the query it was issued for.
+type searchPageToken struct {
+	Query  string `json:"q"`
+	Offset int    `json:"o"`
+	// SearchID carries the analytics id of the first page to the next ones.
+	SearchID string `json:"s,omitempty"`
+}
+
+func searchFingerprint(req searchintegration.SearchRequest) string {
+	hash := sha256.New()
+	for _, part := range append([]string{req.UserQuery, req.QueryType, req.GeoData}, req.UserTags...) {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	hash.Write([]byte(req.Filter.Key()))
+	return base64.RawURLEncoding.EncodeToString(hash.Sum(nil)[:12])
+}
+
+func encodeSearchPageToken(req searchintegration.SearchRequest, offset int, searchID string) string {
+	raw, err := json.Marshal(searchPageToken{Query: searchFingerprint(req), Offset: offset, SearchID: searchID})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeSearchPageToken(raw string, req searchintegration.SearchRequest) (searchPageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return searchPageToken{}, ErrTaskInvalid
+	}
+
+	var token searchPageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return searchPageToken{}, ErrTaskInvalid
+	}
+
+	if token.Query != searchFingerprint(req) || token.Offset < 0 {
+		return searchPageToken{}, ErrTaskInvalid
+	}
+
+	return token, nil
+}
@@ -0,0 +1,132 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	searchintegration "DobrikaDev/task-service/internal/integration/search"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSearchAnalyticsLimit = 10
+	maxSearchAnalyticsLimit     = 100
+)
+
+// GetSearchAnalytics reports the searches logged inside [from, to) with their
+// conversions to joins. A zero to means now and a zero from means 30 days
+// before to; limit caps the query lists.
+func (s *TaskService) GetSearchAnalytics(ctx context.Context, from, to time.Time, limit int) (*domain.SearchAnalytics, error) {
+	if limit < 0 || limit > maxSearchAnalyticsLimit {
+		return nil, ErrTaskInvalid
+	}
+	if limit == 0 {
+		limit = defaultSearchAnalyticsLimit
+	}
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultStatsWindow)
+	}
+	if !from.Before(to) {
+		return nil, ErrTaskInvalid
+	}
+
+	totals, err := s.storage.GetSearchTotals(ctx, from, to)
+	if err != nil {
+		s.logger.Error("failed to get search totals", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	top, err := s.storage.GetSearchQueryStats(ctx, from, to, false, limit)
+	if err != nil {
+		s.logger.Error("failed to get top search queries", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	zeroResult, err := s.storage.GetSearchQueryStats(ctx, from, to, true, limit)
+	if err != nil {
+		s.logger.Error("failed to get zero-result search queries", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	return domain.NewSearchAnalytics(from, to, totals, top, zeroResult), nil
+}
+
+// searchLogFilters is what search_queries.filters holds for a search.
+type searchLogFilters struct {
+	SearchFilter
+	GeoData string   `json:"geo_data,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+// recordSearch hands a finished search to the analytics recorder. The
+// result ids are those of its first page; recordSearchPage adds the others.
+func (s *TaskService) recordSearch(opts SearchOptions, req searchintegration.SearchRequest, page *SearchPage, started time.Time) {
+	filters, err := json.Marshal(searchLogFilters{
+		SearchFilter: opts.Filter,
+		GeoData:      req.GeoData,
+		Tags:         req.UserTags,
+	})
+	if err != nil {
+		s.logger.Warn("failed to encode search filters", zap.Error(err))
+		filters = nil
+	}
+
+	s.recorder.RecordSearch(&domain.SearchEvent{
+		ID:        page.SearchID,
+		UserID:    opts.UserID,
+		Query:     normaliseSearchQuery(req.UserQuery),
+		QueryType: req.QueryType,
+		Filters:   filters,
+		ResultIDs: pageTaskIDs(page),
+		Total:     page.Total,
+		Latency:   time.Since(started),
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// recordSearchPage adds the ids of a continued page to the results logged for
+// its search, so that joins from any page are attributed.
+func (s *TaskService) recordSearchPage(page *SearchPage) {
+	if s.recorder == nil || page.SearchID == "" || len(page.Tasks) == 0 {
+		return
+	}
+
+	s.recorder.RecordSearchPage(&domain.SearchResultPage{
+		SearchID:  page.SearchID,
+		ResultIDs: pageTaskIDs(page),
+	})
+}
+
+func pageTaskIDs(page *SearchPage) []string {
+	ids := make([]string, 0, len(page.Tasks))
+	for _, task := range page.Tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func (s *TaskService) recordSearchJoin(searchID string, userTask *domain.UserTask) {
+	if s.recorder == nil || searchID == "" {
+		return
+	}
+
+	s.recorder.RecordJoin(&domain.SearchJoin{
+		SearchID:  searchID,
+		UserID:    userTask.UserID,
+		TaskID:    userTask.TaskID,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// normaliseSearchQuery lower-cases query and collapses its whitespace so that
+// the same query typed differently is counted once.
+func normaliseSearchQuery(query string) string {
+	return strings.ToLower(strings.Join(strings.Fields(query), " "))
+}
@@ -0,0 +1,78 @@
+package task
+
+import (
+	"slices"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+	searchintegration "DobrikaDev/task-service/internal/integration/search"
+)
+
+func (f SearchFilter) valid() bool {
+	if f.CostMin != nil && f.CostMax != nil && *f.CostMin > *f.CostMax {
+		return false
+	}
+	if !f.CreatedFrom.IsZero() && !f.CreatedTo.IsZero() && f.CreatedFrom.After(f.CreatedTo) {
+		return false
+	}
+	if !f.UpdatedFrom.IsZero() && !f.UpdatedTo.IsZero() && f.UpdatedFrom.After(f.UpdatedTo) {
+		return false
+	}
+	return f.MinOpenSlots >= 0
+}
+
+func (f SearchFilter) toSearch() searchintegration.SearchFilter {
+	types := make([]string, 0, len(f.VerificationTypes))
+	for _, verificationType := range f.VerificationTypes {
+		types = append(types, verificationType.String())
+	}
+
+	return searchintegration.SearchFilter{
+		CustomerIDs:       f.CustomerIDs,
+		VerificationTypes: types,
+		CostMin:           f.CostMin,
+		CostMax:           f.CostMax,
+		MinOpenSlots:      f.MinOpenSlots,
+		CreatedFrom:       f.CreatedFrom,
+		CreatedTo:         f.CreatedTo,
+		UpdatedFrom:       f.UpdatedFrom,
+		UpdatedTo:         f.UpdatedTo,
+	}
+}
+
+// apply keeps the tasks that pass the named filters, in order.
+func (f SearchFilter) apply(tasks []*domain.Task, names []string) []*domain.Task {
+	return slices.DeleteFunc(tasks, func(task *domain.Task) bool {
+		for _, name := range names {
+			if !f.matches(task, name) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (f SearchFilter) matches(task *domain.Task, name string) bool {
+	switch name {
+	case searchintegration.FilterCustomer:
+		return slices.Contains(f.CustomerIDs, task.CustomerID)
+	case searchintegration.FilterVerificationType:
+		return slices.Contains(f.VerificationTypes, task.VerificationType)
+	case searchintegration.FilterCost:
+		return (f.CostMin == nil || task.Cost >= *f.CostMin) && (f.CostMax == nil || task.Cost <= *f.CostMax)
+	case searchintegration.FilterOpenSlots:
+		return task.MembersCount == 0 || task.MembersCount-task.ParticipantsApproved >= f.MinOpenSlots
+	case searchintegration.FilterCreatedAt:
+		return inTimeRange(task.CreatedAt, f.CreatedFrom, f.CreatedTo)
+	case searchintegration.FilterUpdatedAt:
+		return inTimeRange(task.UpdatedAt, f.UpdatedFrom, f.UpdatedTo)
+	default:
+		return true
+	}
+}
+
+// inTimeRange reports whether t is in [from, to); a zero bound leaves that
+// side of the range open.
+func inTimeRange(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+}
@@ -0,0 +1,48 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+const defaultStatsWindow = 30 * 24 * time.Hour
+
+// GetCustomerStats aggregates the customer's tasks and participations inside
+// [from, to). A zero to means now and a zero from means 30 days before to.
+func (s *TaskService) GetCustomerStats(ctx context.Context, customerID string, from, to time.Time, withTasks bool) (*domain.CustomerStats, error) {
+	if customerID == "" {
+		return nil, ErrTaskInvalid
+	}
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultStatsWindow)
+	}
+	if !from.Before(to) {
+		return nil, ErrTaskInvalid
+	}
+
+	taskStats, err := s.storage.GetCustomerTaskStats(ctx, customerID, from, to)
+	if err != nil {
+		s.logger.Error("failed to get customer task stats", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrTaskInternal
+	}
+
+	participants, err := s.storage.CountCustomerParticipants(ctx, customerID, from, to)
+	if err != nil {
+		s.logger.Error("failed to count customer participants", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrTaskInternal
+	}
+
+	stats := domain.NewCustomerStats(customerID, from, to, taskStats, participants)
+	if withTasks {
+		stats.Tasks = taskStats
+	}
+
+	return stats, nil
+}
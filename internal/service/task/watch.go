@@ -0,0 +1,77 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	"DobrikaDev/task-service/internal/broker"
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+func (s *TaskService) WatchTask(ctx context.Context, taskID, afterEventID string) (<-chan broker.Event, error) {
+	if afterEventID == "" {
+		if _, err := s.GetTaskByID(ctx, taskID); err != nil {
+			return nil, err
+		}
+	}
+	return s.subscribe(ctx, broker.Filter{TaskID: taskID}, afterEventID)
+}
+
+func (s *TaskService) WatchUserTasks(ctx context.Context, userID, afterEventID string) (<-chan broker.Event, error) {
+	if userID == "" {
+		return nil, ErrUserTaskInvalid
+	}
+	return s.subscribe(ctx, broker.Filter{UserID: userID}, afterEventID)
+}
+
+func (s *TaskService) subscribe(ctx context.Context, filter broker.Filter, afterEventID string) (<-chan broker.Event, error) {
+	if s.broker == nil {
+		return nil, ErrWatchUnavailable
+	}
+
+	events, err := s.broker.Subscribe(ctx, filter, afterEventID)
+	if err != nil {
+		switch {
+		case errors.Is(err, broker.ErrEventExpired):
+			return nil, ErrWatchEventExpired
+		case errors.Is(err, broker.ErrInvalidID):
+			return nil, ErrWatchEventInvalid
+		case errors.Is(err, broker.ErrClosed):
+			return nil, ErrWatchUnavailable
+		}
+		s.logger.Error("failed to subscribe to task events", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	return events, nil
+}
+
+func (s *TaskService) publishTaskEvent(ctx context.Context, eventType broker.EventType, task *domain.Task) {
+	if task == nil {
+		return
+	}
+	s.publish(ctx, broker.Event{Type: eventType, TaskID: task.ID, Task: task})
+}
+
+func (s *TaskService) publishUserTaskEvent(ctx context.Context, eventType broker.EventType, userTask *domain.UserTask) {
+	if userTask == nil {
+		return
+	}
+	s.publish(ctx, broker.Event{
+		Type:     eventType,
+		TaskID:   userTask.TaskID,
+		UserID:   userTask.UserID,
+		UserTask: userTask,
+	})
+}
+
+func (s *TaskService) publish(ctx context.Context, event broker.Event) {
+	if s.broker == nil {
+		return
+	}
+	if err := s.broker.Publish(ctx, event); err != nil && !errors.Is(err, broker.ErrClosed) {
+		s.logger.Warn("failed to publish task event", zap.Error(err), zap.String("type", string(event.Type)))
+	}
+}
@@ -0,0 +1,9 @@
+package webhook
+
+import "errors"
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+var ErrWebhookInternal = errors.New("webhook internal error")
+var ErrWebhookInvalid = errors.New("webhook invalid")
+
+var ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"DobrikaDev/task-service/internal/domain"
+	webhookintegration "DobrikaDev/task-service/internal/integration/webhook"
+	"DobrikaDev/task-service/internal/storage/sql"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	secretBytes   = 32
+	maxSecretSize = 255
+)
+
+// CreateWebhook registers a subscription. When secret is empty one is
+// generated; the returned subscription is the only place it is exposed.
+func (s *WebhookService) CreateWebhook(
+	ctx context.Context,
+	customerID, rawURL, secret string,
+	eventTypes []domain.WebhookEventType,
+) (*domain.WebhookSubscription, error) {
+	if customerID == "" || !validURL(rawURL) || !validEventTypes(eventTypes) || len(secret) > maxSecretSize {
+		return nil, ErrWebhookInvalid
+	}
+
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			s.logger.Error("failed to generate webhook secret", zap.Error(err))
+			return nil, ErrWebhookInternal
+		}
+		secret = generated
+	}
+
+	subscription := &domain.WebhookSubscription{
+		ID:         uuid.NewString(),
+		CustomerID: customerID,
+		URL:        rawURL,
+		Secret:     secret,
+		Active:     true,
+	}
+	subscription.SetEvents(eventTypes)
+
+	created, err := s.storage.CreateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		return nil, s.convertError(err)
+	}
+
+	return created, nil
+}
+
+func (s *WebhookService) UpdateWebhook(ctx context.Context, id, customerID string, options UpdateWebhookOptions) (*domain.WebhookSubscription, error) {
+	if id == "" || customerID == "" {
+		return nil, ErrWebhookInvalid
+	}
+	if options.URL != nil && !validURL(*options.URL) {
+		return nil, ErrWebhookInvalid
+	}
+	if !validEventTypes(options.EventTypes) {
+		return nil, ErrWebhookInvalid
+	}
+
+	subscription, err := s.storage.GetWebhookSubscription(ctx, id, customerID)
+	if err != nil {
+		return nil, s.convertError(err)
+	}
+
+	if options.URL != nil {
+		subscription.URL = *options.URL
+	}
+	if options.EventTypes != nil {
+		subscription.SetEvents(options.EventTypes)
+	}
+	if options.Active != nil {
+		subscription.Active = *options.Active
+	}
+
+	updated, err := s.storage.UpdateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		return nil, s.convertError(err)
+	}
+
+	return updated, nil
+}
+
+func (s *WebhookService) ListWebhooks(ctx context.Context, customerID string) ([]*domain.WebhookSubscription, error) {
+	if customerID == "" {
+		return nil, ErrWebhookInvalid
+	}
+
+	subscriptions, err := s.storage.GetWebhookSubscriptions(ctx, customerID, false)
+	if err != nil {
+		return nil, s.convertError(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id, customerID string) error {
+	if id == "" || customerID == "" {
+		return ErrWebhookInvalid
+	}
+
+	if err := s.storage.DeleteWebhookSubscription(ctx, id, customerID); err != nil {
+		return s.convertError(err)
+	}
+
+	return nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(
+	ctx context.Context,
+	id, customerID string,
+	limit, offset int,
+) ([]*domain.WebhookDelivery, int, error) {
+	if id == "" || customerID == "" || limit < 0 || offset < 0 {
+		return nil, 0, ErrWebhookInvalid
+	}
+
+	// Ownership check: deliveries are only visible to the subscription owner.
+	if _, err := s.storage.GetWebhookSubscription(ctx, id, customerID); err != nil {
+		return nil, 0, s.convertError(err)
+	}
+
+	deliveries, total, err := s.storage.GetWebhookDeliveries(ctx, id, limit, offset)
+	if err != nil {
+		return nil, 0, s.convertError(err)
+	}
+
+	return deliveries, total, nil
+}
+
+// RedeliverWebhook queues a delivery again regardless of its current status.
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, deliveryID int64, customerID string) (*domain.WebhookDelivery, error) {
+	if deliveryID <= 0 || customerID == "" {
+		return nil, ErrWebhookInvalid
+	}
+
+	delivery, err := s.storage.ResetWebhookDelivery(ctx, deliveryID, customerID)
+	if err != nil {
+		return nil, s.convertError(err)
+	}
+
+	return delivery, nil
+}
+
+func (s *WebhookService) convertError(err error) error {
+	switch {
+	case errors.Is(err, sql.ErrWebhookNotFound):
+		return ErrWebhookNotFound
+	case errors.Is(err, sql.ErrWebhookDeliveryNotFound):
+		return ErrWebhookDeliveryNotFound
+	default:
+		s.logger.Error("webhook storage error", zap.Error(err))
+		return ErrWebhookInternal
+	}
+}
+
+// validURL accepts absolute http(s) urls that do not point at loopback,
+// link-local or private addresses.
+func validURL(rawURL string) bool {
+	return webhookintegration.ValidateURL(rawURL) == nil
+}
+
+func validEventTypes(eventTypes []domain.WebhookEventType) bool {
+	for _, eventType := range eventTypes {
+		if !eventType.Valid() {
+			return false
+		}
+	}
+	return true
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+type storage interface {
+	CreateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, id, customerID string) (*domain.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context, customerID string, activeOnly bool) ([]*domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id, customerID string) error
+
+	GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, int, error)
+	ResetWebhookDelivery(ctx context.Context, id int64, customerID string) (*domain.WebhookDelivery, error)
+}
+
+type WebhookService struct {
+	storage storage
+	logger  *zap.Logger
+}
+
+func NewWebhookService(storage storage, logger *zap.Logger) *WebhookService {
+	return &WebhookService{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// UpdateWebhookOptions carries the fields of an update; nil fields are left
+// unchanged.
+type UpdateWebhookOptions struct {
+	URL        *string
+	EventTypes []domain.WebhookEventType
+	Active     *bool
+}
@@ -21,6 +21,7 @@ type TransactionFactory interface {
 type TransactionManager interface {
 	Do(ctx context.Context, fn func(ctx context.Context) error) error
 	DoWithCancel(ctx context.Context, fn func(ctx context.Context) error) error
+	DoNested(ctx context.Context, fn func(ctx context.Context) error) error
 	DoWithTimeout(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error
 }
 
@@ -38,6 +39,10 @@ func (*TrmStub) DoWithCancel(ctx context.Context, fn func(ctx context.Context) e
 	return fn(ctx)
 }
 
+func (*TrmStub) DoNested(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func (*TrmStub) DoWithTimeout(
 	ctx context.Context,
 	_ time.Duration,
@@ -17,4 +17,19 @@ var (
 	ErrFeedbackInternal      = errors.New("feedback internal error")
 	ErrFeedbackInvalid       = errors.New("feedback invalid")
 	ErrFeedbackAlreadyExists = errors.New("feedback already exists")
+
+	ErrOutboxInternal = errors.New("outbox internal error")
+
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookInternal         = errors.New("webhook internal error")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	ErrNotificationInternal            = errors.New("notification internal error")
+	ErrNotificationPreferencesNotFound = errors.New("notification preferences not found")
+
+	ErrIndexJobInternal = errors.New("index job internal error")
+
+	ErrSearchAnalyticsInternal = errors.New("search analytics internal error")
+
+	ErrSearchCacheInternal = errors.New("search cache internal error")
 )
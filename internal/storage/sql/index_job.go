@@ -0,0 +1,273 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const indexJobTableName = "search_index_jobs"
+
+var indexJobSelectColumns = []string{
+	"j.id",
+	"j.task_id",
+	"j.status",
+	"j.attempts",
+	"j.next_attempt_at",
+	"j.last_error",
+	"j.created_at",
+	"j.updated_at",
+}
+
+// EnqueueIndexJobs adds a pending job for every task id. Callers run it in the
+// transaction of the task change so that the job exists exactly when the
+// change does.
+func (s *SqlStorage) EnqueueIndexJobs(ctx context.Context, taskIDs []string) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(indexJobTableName).
+		Columns("task_id").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, id := range taskIDs {
+		ib = ib.Values(id)
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to enqueue index jobs", zap.Error(err), zap.Strings("task_ids", taskIDs))
+		return ErrIndexJobInternal
+	}
+
+	return nil
+}
+
+// LockDueIndexJobs returns up to limit pending jobs whose next attempt is due
+// and locks them with SKIP LOCKED. It must be called inside a transaction.
+func (s *SqlStorage) LockDueIndexJobs(ctx context.Context, limit int) ([]*domain.IndexJob, error) {
+	query, args := sq.Select(indexJobSelectColumns...).
+		From(fmt.Sprintf("%s j", indexJobTableName)).
+		Where(sq.Eq{"j.status": domain.IndexJobPending}).
+		Where(sq.Expr("j.next_attempt_at <= NOW()")).
+		OrderBy("j.next_attempt_at ASC", "j.id ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	jobs := make([]*domain.IndexJob, 0, limit)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &jobs, query, args...); err != nil {
+		s.logger.Error("failed to lock due index jobs", zap.Error(err))
+		return nil, ErrIndexJobInternal
+	}
+
+	return jobs, nil
+}
+
+// ClaimIndexJobs moves the next attempt of locked jobs to until so that they
+// can be worked on after the locking transaction commits.
+func (s *SqlStorage) ClaimIndexJobs(ctx context.Context, ids []int64, until time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args := sq.Update(indexJobTableName).
+		Set("next_attempt_at", until).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to claim index jobs", zap.Error(err), zap.Int64s("ids", ids))
+		return ErrIndexJobInternal
+	}
+
+	return nil
+}
+
+// CompleteIndexJobs removes finished jobs from the queue.
+func (s *SqlStorage) CompleteIndexJobs(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args := sq.Delete(indexJobTableName).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to complete index jobs", zap.Error(err), zap.Int64s("ids", ids))
+		return ErrIndexJobInternal
+	}
+
+	return nil
+}
+
+// RecordIndexJobFailure counts a failed attempt and either reschedules the job
+// for nextAttemptAt or, with status dead, parks it.
+func (s *SqlStorage) RecordIndexJobFailure(
+	ctx context.Context,
+	id int64,
+	status domain.IndexJobStatus,
+	cause string,
+	nextAttemptAt time.Time,
+) error {
+	query, args := sq.Update(indexJobTableName).
+		Set("status", status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", truncate(cause, 1024)).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to record index job failure", zap.Error(err), zap.Int64("id", id))
+		return ErrIndexJobInternal
+	}
+
+	return nil
+}
+
+// EnqueueCustomerIndexJobs adds a pending job for every task of customerID, or
+// of every customer when it is empty, and returns how many were queued.
+func (s *SqlStorage) EnqueueCustomerIndexJobs(ctx context.Context, customerID string) (int, error) {
+	sb := sq.Select("id").From(taskTableName)
+	if customerID != "" {
+		sb = sb.Where(sq.Eq{"customer_id": customerID})
+	}
+
+	query, args := sq.Insert(indexJobTableName).
+		Columns("task_id").
+		Select(sb).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to enqueue customer index jobs", zap.Error(err), zap.String("customer_id", customerID))
+		return 0, ErrIndexJobInternal
+	}
+
+	queued, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("failed to count enqueued index jobs", zap.Error(err))
+		return 0, ErrIndexJobInternal
+	}
+
+	return int(queued), nil
+}
+
+// CountPendingIndexJobs returns the number of jobs still waiting to be
+// processed, due or not.
+func (s *SqlStorage) CountPendingIndexJobs(ctx context.Context) (int, error) {
+	counts, err := s.CountIndexJobsByStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return counts[domain.IndexJobPending], nil
+}
+
+func (s *SqlStorage) CountIndexJobsByStatus(ctx context.Context) (map[domain.IndexJobStatus]int, error) {
+	query, args := sq.Select("status", "COUNT(*) AS count").
+		From(indexJobTableName).
+		GroupBy("status").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	rows := make([]struct {
+		Status domain.IndexJobStatus `db:"status"`
+		Count  int                   `db:"count"`
+	}, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &rows, query, args...); err != nil {
+		s.logger.Error("failed to count index jobs", zap.Error(err))
+		return nil, ErrIndexJobInternal
+	}
+
+	counts := make(map[domain.IndexJobStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// GetIndexJobs returns jobs with status, oldest failure first, and the total
+// count of such jobs.
+func (s *SqlStorage) GetIndexJobs(ctx context.Context, status domain.IndexJobStatus, limit, offset int) ([]*domain.IndexJob, int, error) {
+	sb := sq.Select(indexJobSelectColumns...).
+		From(fmt.Sprintf("%s j", indexJobTableName)).
+		Where(sq.Eq{"j.status": status}).
+		OrderBy("j.updated_at ASC", "j.id ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+	if offset > 0 {
+		sb = sb.Offset(uint64(offset))
+	}
+
+	query, args := sb.MustSql()
+
+	jobs := make([]*domain.IndexJob, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &jobs, query, args...); err != nil {
+		s.logger.Error("failed to get index jobs", zap.Error(err), zap.String("status", status.String()))
+		return nil, 0, ErrIndexJobInternal
+	}
+
+	countQuery, countArgs := sq.Select("COUNT(*)").
+		From(indexJobTableName).
+		Where(sq.Eq{"status": status}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var total int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &total, countQuery, countArgs...); err != nil {
+		s.logger.Error("failed to count index jobs", zap.Error(err), zap.String("status", status.String()))
+		return nil, 0, ErrIndexJobInternal
+	}
+
+	return jobs, total, nil
+}
+
+// ReplayDeadIndexJobs puts dead jobs back in the queue with a fresh attempt
+// budget. An empty ids replays every dead job. It returns how many were
+// replayed.
+func (s *SqlStorage) ReplayDeadIndexJobs(ctx context.Context, ids []int64) (int, error) {
+	ub := sq.Update(indexJobTableName).
+		Set("status", domain.IndexJobPending).
+		Set("attempts", 0).
+		Set("next_attempt_at", sq.Expr("NOW()")).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"status": domain.IndexJobDead}).
+		PlaceholderFormat(sq.Dollar)
+
+	if len(ids) > 0 {
+		ub = ub.Where(sq.Eq{"id": ids})
+	}
+
+	query, args := ub.MustSql()
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to replay dead index jobs", zap.Error(err), zap.Int64s("ids", ids))
+		return 0, ErrIndexJobInternal
+	}
+
+	replayed, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("failed to count replayed index jobs", zap.Error(err))
+		return 0, ErrIndexJobInternal
+	}
+
+	return int(replayed), nil
+}
@@ -0,0 +1,306 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const (
+	notificationTableName            = "notifications"
+	notificationPreferencesTableName = "notification_preferences"
+)
+
+var notificationSelectColumns = []string{
+	"id",
+	"recipient_id",
+	"event_id",
+	"type",
+	"task_id",
+	"title",
+	"body",
+	"channels",
+	"send_after",
+	"attempts",
+	"last_error",
+	"sent_at",
+	"read_at",
+	"created_at",
+}
+
+var notificationPreferencesSelectColumns = []string{
+	"user_id",
+	"channels",
+	"quiet_hours_start",
+	"quiet_hours_end",
+	"timezone",
+	"updated_at",
+}
+
+// CreateNotifications stores notifications, skipping the ones already created
+// for the same recipient, event and type.
+func (s *SqlStorage) CreateNotifications(ctx context.Context, notifications []*domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(notificationTableName).
+		Columns("id", "recipient_id", "event_id", "type", "task_id", "title", "body", "channels", "send_after")
+	for _, notification := range notifications {
+		ib = ib.Values(
+			notification.ID,
+			notification.RecipientID,
+			notification.EventID,
+			notification.Type,
+			notification.TaskID,
+			notification.Title,
+			notification.Body,
+			notification.Channels,
+			notification.SendAfter,
+		)
+	}
+
+	query, args := ib.
+		Suffix("ON CONFLICT (recipient_id, event_id, type) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to create notifications", zap.Error(err))
+		return ErrNotificationInternal
+	}
+
+	return nil
+}
+
+// GetNotifications returns a page of the recipient's inbox, newest first,
+// with the total count for the same filter.
+func (s *SqlStorage) GetNotifications(
+	ctx context.Context,
+	recipientID string,
+	unreadOnly bool,
+	limit, offset int,
+) ([]*domain.Notification, int, error) {
+	where := sq.And{sq.Eq{"recipient_id": recipientID}}
+	if unreadOnly {
+		where = append(where, sq.Eq{"read_at": nil})
+	}
+
+	sb := sq.Select(notificationSelectColumns...).
+		From(notificationTableName).
+		Where(where).
+		OrderBy("created_at DESC", "id DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+	if offset > 0 {
+		sb = sb.Offset(uint64(offset))
+	}
+
+	query, args := sb.MustSql()
+
+	notifications := make([]*domain.Notification, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &notifications, query, args...); err != nil {
+		s.logger.Error("failed to get notifications", zap.Error(err), zap.String("recipient_id", recipientID))
+		return nil, 0, ErrNotificationInternal
+	}
+
+	query, args = sq.Select("COUNT(*)").
+		From(notificationTableName).
+		Where(where).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count notifications", zap.Error(err), zap.String("recipient_id", recipientID))
+		return nil, 0, ErrNotificationInternal
+	}
+
+	return notifications, count, nil
+}
+
+func (s *SqlStorage) CountUnreadNotifications(ctx context.Context, recipientID string) (int, error) {
+	query, args := sq.Select("COUNT(*)").
+		From(notificationTableName).
+		Where(sq.Eq{"recipient_id": recipientID, "read_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count unread notifications", zap.Error(err), zap.String("recipient_id", recipientID))
+		return 0, ErrNotificationInternal
+	}
+
+	return count, nil
+}
+
+// MarkNotificationsRead marks the given unread notifications of the recipient
+// as read, or all of them when ids is empty, and returns how many changed.
+func (s *SqlStorage) MarkNotificationsRead(ctx context.Context, recipientID string, ids []string) (int, error) {
+	ub := sq.Update(notificationTableName).
+		Set("read_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"recipient_id": recipientID, "read_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	if len(ids) > 0 {
+		ub = ub.Where(sq.Eq{"id": ids})
+	}
+
+	query, args := ub.MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to mark notifications read", zap.Error(err), zap.String("recipient_id", recipientID))
+		return 0, ErrNotificationInternal
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return 0, ErrNotificationInternal
+	}
+
+	return int(updated), nil
+}
+
+// LockDueNotifications returns unsent notifications with external channels
+// whose send_after has passed and locks them with SKIP LOCKED. It must be
+// called inside a transaction.
+func (s *SqlStorage) LockDueNotifications(ctx context.Context, limit, maxAttempts int) ([]*domain.Notification, error) {
+	query, args := sq.Select(notificationSelectColumns...).
+		From(notificationTableName).
+		Where(sq.Eq{"sent_at": nil}).
+		Where(sq.NotEq{"channels": ""}).
+		Where(sq.Lt{"attempts": maxAttempts}).
+		Where(sq.Expr("send_after <= NOW()")).
+		OrderBy("send_after ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	notifications := make([]*domain.Notification, 0, limit)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &notifications, query, args...); err != nil {
+		s.logger.Error("failed to lock due notifications", zap.Error(err))
+		return nil, ErrNotificationInternal
+	}
+
+	return notifications, nil
+}
+
+// ClaimNotifications moves send_after of locked notifications to until so
+// that they can be sent after the locking transaction commits without another
+// replica picking them up.
+func (s *SqlStorage) ClaimNotifications(ctx context.Context, ids []string, until time.Time) error {
+	query, args := sq.Update(notificationTableName).
+		Set("send_after", until).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to claim notifications", zap.Error(err))
+		return ErrNotificationInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) MarkNotificationSent(ctx context.Context, id string) error {
+	query, args := sq.Update(notificationTableName).
+		Set("sent_at", sq.Expr("NOW()")).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", nil).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark notification sent", zap.Error(err), zap.String("id", id))
+		return ErrNotificationInternal
+	}
+
+	return nil
+}
+
+// MarkNotificationFailed records a failed attempt and schedules the next one
+// at retryAt.
+func (s *SqlStorage) MarkNotificationFailed(ctx context.Context, id, cause string, retryAt time.Time) error {
+	query, args := sq.Update(notificationTableName).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", truncate(cause, 1024)).
+		Set("send_after", retryAt).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark notification failed", zap.Error(err), zap.String("id", id))
+		return ErrNotificationInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) GetNotificationPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	query, args := sq.Select(notificationPreferencesSelectColumns...).
+		From(notificationPreferencesTableName).
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var preferences domain.NotificationPreferences
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &preferences, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotificationPreferencesNotFound
+		}
+		s.logger.Error("failed to get notification preferences", zap.Error(err), zap.String("user_id", userID))
+		return nil, ErrNotificationInternal
+	}
+
+	return &preferences, nil
+}
+
+func (s *SqlStorage) SaveNotificationPreferences(
+	ctx context.Context,
+	preferences *domain.NotificationPreferences,
+) (*domain.NotificationPreferences, error) {
+	query, args := sq.Insert(notificationPreferencesTableName).
+		Columns("user_id", "channels", "quiet_hours_start", "quiet_hours_end", "timezone").
+		Values(
+			preferences.UserID,
+			preferences.Channels,
+			preferences.QuietHoursStart,
+			preferences.QuietHoursEnd,
+			preferences.Timezone,
+		).
+		Suffix(
+			"ON CONFLICT (user_id) DO UPDATE SET " +
+				"channels = EXCLUDED.channels, " +
+				"quiet_hours_start = EXCLUDED.quiet_hours_start, " +
+				"quiet_hours_end = EXCLUDED.quiet_hours_end, " +
+				"timezone = EXCLUDED.timezone, " +
+				"updated_at = NOW() " +
+				"RETURNING " + strings.Join(notificationPreferencesSelectColumns, ", "),
+		).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var saved domain.NotificationPreferences
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &saved, query, args...); err != nil {
+		s.logger.Error("failed to save notification preferences", zap.Error(err), zap.String("user_id", preferences.UserID))
+		return nil, ErrNotificationInternal
+	}
+
+	return &saved, nil
+}
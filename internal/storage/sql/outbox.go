@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const outboxTableName = "outbox_events"
+
+var outboxSelectColumns = []string{
+	"id",
+	"event_id",
+	"event_type",
+	"aggregate_id",
+	"schema_version",
+	"payload",
+	"attempts",
+	"last_error",
+	"next_attempt_at",
+	"published_at",
+	"created_at",
+}
+
+// AddOutboxEvent stores event in the transaction carried by ctx, so it
+// commits or rolls back together with the change it describes.
+func (s *SqlStorage) AddOutboxEvent(ctx context.Context, event *domain.OutboxEvent) error {
+	query, args := sq.Insert(outboxTableName).
+		Columns("event_id", "event_type", "aggregate_id", "schema_version", "payload").
+		Values(event.EventID, event.EventType, event.AggregateID, event.SchemaVersion, event.Payload).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error(
+			"failed to add outbox event",
+			zap.Error(err),
+			zap.String("event_id", event.EventID),
+			zap.String("event_type", event.EventType.String()),
+		)
+		return ErrOutboxInternal
+	}
+
+	return nil
+}
+
+// LockPendingOutboxEvents returns up to limit due, unpublished events in
+// insertion order and locks them with SKIP LOCKED so that concurrent relays
+// never pick the same rows. Only the oldest unpublished event of each
+// aggregate is returned: later ones wait until it is published, even while it
+// backs off after a failure or is locked by another relay, so the events of
+// an aggregate are published in order. It must be called inside a
+// transaction.
+func (s *SqlStorage) LockPendingOutboxEvents(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
+	query, args := sq.Select(outboxSelectColumns...).
+		From(outboxTableName + " o").
+		Where(sq.Eq{"o.published_at": nil}).
+		Where(sq.Expr("o.next_attempt_at <= NOW()")).
+		Where(sq.Expr(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %s p WHERE p.aggregate_id = o.aggregate_id AND p.published_at IS NULL AND p.id < o.id)",
+			outboxTableName,
+		))).
+		OrderBy("o.id ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	events := make([]*domain.OutboxEvent, 0, limit)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &events, query, args...); err != nil {
+		s.logger.Error("failed to lock pending outbox events", zap.Error(err))
+		return nil, ErrOutboxInternal
+	}
+
+	return events, nil
+}
+
+func (s *SqlStorage) MarkOutboxEventsPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args := sq.Update(outboxTableName).
+		Set("published_at", sq.Expr("NOW()")).
+		Set("last_error", nil).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark outbox events published", zap.Error(err))
+		return ErrOutboxInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) MarkOutboxEventFailed(ctx context.Context, id int64, cause string, nextAttemptAt time.Time) error {
+	query, args := sq.Update(outboxTableName).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", truncate(cause, 1024)).
+		Set("next_attempt_at", nextAttemptAt).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark outbox event failed", zap.Error(err), zap.Int64("id", id))
+		return ErrOutboxInternal
+	}
+
+	return nil
+}
+
+// DeletePublishedOutboxEvents removes events published before olderThan.
+func (s *SqlStorage) DeletePublishedOutboxEvents(ctx context.Context, olderThan time.Time) (int, error) {
+	query, args := sq.Delete(outboxTableName).
+		Where(sq.NotEq{"published_at": nil}).
+		Where(sq.Lt{"published_at": olderThan}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to delete published outbox events", zap.Error(err))
+		return 0, ErrOutboxInternal
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, ErrOutboxInternal
+	}
+
+	return int(deleted), nil
+}
+
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	return strings.ToValidUTF8(value[:limit], "")
+}
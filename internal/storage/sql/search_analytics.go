@@ -0,0 +1,202 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const (
+	searchQueryTableName  = "search_queries"
+	searchJoinTableName   = "search_joins"
+	searchResultTableName = "search_results"
+)
+
+// CreateSearchEvents logs searches with the results of their first page;
+// events already logged are skipped.
+func (s *SqlStorage) CreateSearchEvents(ctx context.Context, events []*domain.SearchEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(searchQueryTableName).
+		Columns("id", "user_id", "query", "query_type", "filters", "result_ids", "result_count", "total", "latency_ms", "created_at").
+		Suffix("ON CONFLICT (id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, event := range events {
+		filters := event.Filters
+		if len(filters) == 0 {
+			filters = json.RawMessage("{}")
+		}
+		resultIDs, err := json.Marshal(event.ResultIDs)
+		if err != nil {
+			s.logger.Error("failed to encode search result ids", zap.Error(err), zap.String("search_id", event.ID))
+			return ErrSearchAnalyticsInternal
+		}
+		if event.ResultIDs == nil {
+			resultIDs = []byte("[]")
+		}
+
+		ib = ib.Values(
+			event.ID,
+			event.UserID,
+			event.Query,
+			event.QueryType,
+			string(filters),
+			string(resultIDs),
+			len(event.ResultIDs),
+			event.Total,
+			event.Latency.Milliseconds(),
+			event.CreatedAt,
+		)
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to create search events", zap.Error(err), zap.Int("count", len(events)))
+		return ErrSearchAnalyticsInternal
+	}
+
+	pages := make([]*domain.SearchResultPage, 0, len(events))
+	for _, event := range events {
+		pages = append(pages, &domain.SearchResultPage{SearchID: event.ID, ResultIDs: event.ResultIDs})
+	}
+
+	return s.CreateSearchResults(ctx, pages)
+}
+
+// CreateSearchResults logs the task ids shown by pages of searches. The
+// search itself need not be logged yet; ids already logged are skipped.
+func (s *SqlStorage) CreateSearchResults(ctx context.Context, pages []*domain.SearchResultPage) error {
+	ib := sq.Insert(searchResultTableName).
+		Columns("search_id", "task_id").
+		Suffix("ON CONFLICT (search_id, task_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	var rows int
+	for _, page := range pages {
+		for _, taskID := range page.ResultIDs {
+			ib = ib.Values(page.SearchID, taskID)
+			rows++
+		}
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to create search results", zap.Error(err), zap.Int("count", rows))
+		return ErrSearchAnalyticsInternal
+	}
+
+	return nil
+}
+
+// CreateSearchJoins attributes joins to searches; a repeated attribution of
+// the same join is skipped.
+func (s *SqlStorage) CreateSearchJoins(ctx context.Context, joins []*domain.SearchJoin) error {
+	if len(joins) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(searchJoinTableName).
+		Columns("search_id", "user_id", "task_id", "created_at").
+		Suffix("ON CONFLICT (search_id, user_id, task_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, join := range joins {
+		ib = ib.Values(join.SearchID, join.UserID, join.TaskID, join.CreatedAt)
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to create search joins", zap.Error(err), zap.Int("count", len(joins)))
+		return ErrSearchAnalyticsInternal
+	}
+
+	return nil
+}
+
+// searchesInWindow selects the searches logged inside [from, to) with the
+// number of joins attributed to each. A join counts only when its task is
+// among the logged results of any page of the search, so that a made-up or
+// mismatched search id does not inflate conversions.
+func searchesInWindow(from, to time.Time) sq.SelectBuilder {
+	return sq.Select("q.id", "q.query", "q.result_count", "q.latency_ms", "COUNT(j.task_id) AS joins").
+		From(fmt.Sprintf("%s q", searchQueryTableName)).
+		LeftJoin(fmt.Sprintf(
+			"%s j ON j.search_id = q.id AND EXISTS "+
+				"(SELECT 1 FROM %s r WHERE r.search_id = j.search_id AND r.task_id = j.task_id)",
+			searchJoinTableName,
+			searchResultTableName,
+		)).
+		Where(sq.GtOrEq{"q.created_at": from}).
+		Where(sq.Lt{"q.created_at": to}).
+		GroupBy("q.id")
+}
+
+// GetSearchTotals aggregates the searches logged inside [from, to).
+func (s *SqlStorage) GetSearchTotals(ctx context.Context, from, to time.Time) (domain.SearchTotals, error) {
+	query, args := sq.Select(
+		"COUNT(*) AS searches",
+		"COUNT(*) FILTER (WHERE s.result_count = 0) AS zero_results",
+		"COUNT(*) FILTER (WHERE s.joins > 0) AS converted",
+		"COALESCE(SUM(s.joins), 0) AS joins",
+		"COALESCE(AVG(s.latency_ms), 0)::float8 AS avg_latency_ms",
+	).
+		FromSelect(searchesInWindow(from, to), "s").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var totals domain.SearchTotals
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &totals, query, args...); err != nil {
+		s.logger.Error("failed to get search totals", zap.Error(err))
+		return domain.SearchTotals{}, ErrSearchAnalyticsInternal
+	}
+
+	return totals, nil
+}
+
+// GetSearchQueryStats aggregates the searches logged inside [from, to) by
+// query, most searched first. With zeroResultsOnly only queries that came
+// back empty at least once are returned, ordered by how often they did.
+func (s *SqlStorage) GetSearchQueryStats(ctx context.Context, from, to time.Time, zeroResultsOnly bool, limit int) ([]*domain.SearchQueryStats, error) {
+	sb := sq.Select(
+		"s.query",
+		"COUNT(*) AS searches",
+		"COUNT(*) FILTER (WHERE s.result_count = 0) AS zero_results",
+		"COUNT(*) FILTER (WHERE s.joins > 0) AS converted",
+		"COALESCE(SUM(s.joins), 0) AS joins",
+	).
+		FromSelect(searchesInWindow(from, to), "s").
+		GroupBy("s.query").
+		PlaceholderFormat(sq.Dollar)
+
+	if zeroResultsOnly {
+		sb = sb.Having("COUNT(*) FILTER (WHERE s.result_count = 0) > 0").
+			OrderBy("zero_results DESC", "searches DESC", "s.query")
+	} else {
+		sb = sb.OrderBy("searches DESC", "s.query")
+	}
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+
+	query, args := sb.MustSql()
+
+	stats := make([]*domain.SearchQueryStats, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &stats, query, args...); err != nil {
+		s.logger.Error("failed to get search query stats", zap.Error(err), zap.Bool("zero_results_only", zeroResultsOnly))
+		return nil, ErrSearchAnalyticsInternal
+	}
+
+	return stats, nil
+}
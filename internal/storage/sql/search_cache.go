@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const searchCacheInvalidationTableName = "search_cache_invalidations"
+
+// CreateSearchCacheInvalidations queues taskIDs for the result caches of all
+// replicas.
+func (s *SqlStorage) CreateSearchCacheInvalidations(ctx context.Context, taskIDs []string) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(searchCacheInvalidationTableName).
+		Columns("task_id").
+		PlaceholderFormat(sq.Dollar)
+	for _, taskID := range taskIDs {
+		ib = ib.Values(taskID)
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to create search cache invalidations", zap.Error(err))
+		return ErrSearchCacheInternal
+	}
+
+	return nil
+}
+
+// GetSearchCacheInvalidationsAfter returns invalidations with an id above
+// afterID in id order.
+func (s *SqlStorage) GetSearchCacheInvalidationsAfter(
+	ctx context.Context,
+	afterID int64,
+	limit int,
+) ([]*domain.SearchCacheInvalidation, error) {
+	sb := sq.Select("id", "task_id").
+		From(searchCacheInvalidationTableName).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+
+	query, args := sb.MustSql()
+
+	invalidations := make([]*domain.SearchCacheInvalidation, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &invalidations, query, args...); err != nil {
+		s.logger.Error("failed to get search cache invalidations", zap.Error(err), zap.Int64("after_id", afterID))
+		return nil, ErrSearchCacheInternal
+	}
+
+	return invalidations, nil
+}
+
+// GetLatestSearchCacheInvalidationID returns the highest invalidation id, or
+// zero when there is none.
+func (s *SqlStorage) GetLatestSearchCacheInvalidationID(ctx context.Context) (int64, error) {
+	query, args := sq.Select("COALESCE(MAX(id), 0)").
+		From(searchCacheInvalidationTableName).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var id int64
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &id, query, args...); err != nil {
+		s.logger.Error("failed to get latest search cache invalidation", zap.Error(err))
+		return 0, ErrSearchCacheInternal
+	}
+
+	return id, nil
+}
+
+// DeleteSearchCacheInvalidationsBefore removes invalidations created before
+// the given time and returns how many were deleted.
+func (s *SqlStorage) DeleteSearchCacheInvalidationsBefore(ctx context.Context, before time.Time) (int, error) {
+	query, args := sq.Delete(searchCacheInvalidationTableName).
+		Where(sq.Lt{"created_at": before}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to delete search cache invalidations", zap.Error(err))
+		return 0, ErrSearchCacheInternal
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, ErrSearchCacheInternal
+	}
+
+	return int(deleted), nil
+}
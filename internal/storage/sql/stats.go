@@ -0,0 +1,77 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+// GetCustomerTaskStats aggregates participations of every task owned by
+// customerID that existed before to. Participation events are counted when
+// they happened inside [from, to).
+func (s *SqlStorage) GetCustomerTaskStats(ctx context.Context, customerID string, from, to time.Time) ([]*domain.TaskStats, error) {
+	inWindow := func(column string) string {
+		return fmt.Sprintf("ut.%s >= ? AND ut.%s < ?", column, column)
+	}
+
+	query, args := sq.Select(
+		"t.id AS task_id",
+		"t.name",
+		"t.cost",
+	).
+		Column(sq.Expr(fmt.Sprintf(
+			"(t.created_at >= ? OR COUNT(ut.task_id) FILTER (WHERE %s) > 0) AS active", inWindow("updated_at"),
+		), from, from, to)).
+		Column(sq.Expr(fmt.Sprintf("COUNT(ut.task_id) FILTER (WHERE %s) AS joined", inWindow("created_at")), from, to)).
+		Column(sq.Expr(fmt.Sprintf("COUNT(ut.task_id) FILTER (WHERE %s) AS completed", inWindow("completed_at")), from, to)).
+		Column(sq.Expr(fmt.Sprintf("COUNT(ut.task_id) FILTER (WHERE %s) AS approved", inWindow("approved_at")), from, to)).
+		Column(sq.Expr(fmt.Sprintf(
+			"COUNT(ut.task_id) FILTER (WHERE ut.status = 'rejected' AND %s) AS rejected", inWindow("updated_at"),
+		), from, to)).
+		Column(sq.Expr(fmt.Sprintf(
+			"COALESCE(SUM(EXTRACT(EPOCH FROM ut.completed_at - ut.created_at)) FILTER (WHERE %s), 0)::float8 AS completion_seconds_total",
+			inWindow("completed_at"),
+		), from, to)).
+		From(fmt.Sprintf("%s t", taskTableName)).
+		LeftJoin(fmt.Sprintf("%s ut ON ut.task_id = t.id", userTaskTableName)).
+		Where(sq.Eq{"t.customer_id": customerID}).
+		Where(sq.Lt{"t.created_at": to}).
+		GroupBy("t.id").
+		OrderBy("t.created_at DESC", "t.id").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	stats := make([]*domain.TaskStats, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &stats, query, args...); err != nil {
+		s.logger.Error("failed to get customer task stats", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrTaskInternal
+	}
+
+	return stats, nil
+}
+
+// CountCustomerParticipants counts distinct users that joined any task of
+// customerID inside [from, to).
+func (s *SqlStorage) CountCustomerParticipants(ctx context.Context, customerID string, from, to time.Time) (int, error) {
+	query, args := sq.Select("COUNT(DISTINCT ut.user_id)").
+		From(fmt.Sprintf("%s ut", userTaskTableName)).
+		Join(fmt.Sprintf("%s t ON t.id = ut.task_id", taskTableName)).
+		Where(sq.Eq{"t.customer_id": customerID}).
+		Where(sq.GtOrEq{"ut.created_at": from}).
+		Where(sq.Lt{"ut.created_at": to}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count customer participants", zap.Error(err), zap.String("customer_id", customerID))
+		return 0, ErrUserTaskInternal
+	}
+
+	return count, nil
+}
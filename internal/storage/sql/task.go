@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -29,10 +30,19 @@ var taskSelectColumns = []string{
 	"t.cost",
 	"t.members_count",
 	"COALESCE(t.meta, '{}'::jsonb) AS meta",
+	"t.participants_pending",
+	"t.participants_completed",
+	"t.participants_approved",
+	"t.participants_rejected",
+	"t.participants_cancelled",
 	"t.created_at",
 	"t.updated_at",
 }
 
+const taskReturningColumns = "RETURNING id, customer_id, name, description, verification_type, cost, members_count, " +
+	"COALESCE(meta, '{}'::jsonb) AS meta, participants_pending, participants_completed, participants_approved, " +
+	"participants_rejected, participants_cancelled, created_at, updated_at"
+
 type (
 	taskOption interface {
 		applySelect(sq.SelectBuilder) sq.SelectBuilder
@@ -235,6 +245,145 @@ func WithTaskVerificationTypes(verificationTypes []domain.VerificationType) GetT
 	}
 }
 
+func WithTaskCostRange(min, max *int) GetTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if min != nil {
+			sb = sb.Where(sq.GtOrEq{"t.cost": *min})
+		}
+		if max != nil {
+			sb = sb.Where(sq.LtOrEq{"t.cost": *max})
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+// WithTaskMinOpenSlots keeps tasks with at least n open slots. Tasks without
+// a member limit always match.
+func WithTaskMinOpenSlots(n int) GetTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if n > 0 {
+			sb = sb.Where(sq.Expr("(t.members_count = 0 OR t.members_count - t.participants_approved >= ?)", n))
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+func WithTaskCreatedBetween(from, to time.Time) GetTasksOption {
+	return withTaskTimeRange("t.created_at", from, to)
+}
+
+func WithTaskUpdatedBetween(from, to time.Time) GetTasksOption {
+	return withTaskTimeRange("t.updated_at", from, to)
+}
+
+// withTaskTimeRange filters column into [from, to). A zero bound leaves that
+// side of the range open.
+func withTaskTimeRange(column string, from, to time.Time) GetTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if !from.IsZero() {
+			sb = sb.Where(sq.GtOrEq{column: from})
+		}
+		if !to.IsZero() {
+			sb = sb.Where(sq.Lt{column: to})
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+type TaskSortField string
+
+const (
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortUpdatedAt TaskSortField = "updated_at"
+	TaskSortCost      TaskSortField = "cost"
+	TaskSortName      TaskSortField = "name"
+)
+
+func (f TaskSortField) valid() bool {
+	switch f {
+	case TaskSortCreatedAt, TaskSortUpdatedAt, TaskSortCost, TaskSortName:
+		return true
+	default:
+		return false
+	}
+}
+
+// taskSortOption orders the listing by field and breaks ties by id so that
+// pages are stable. GetTasks falls back to created_at DESC without it.
+type taskSortOption struct {
+	field TaskSortField
+	desc  bool
+}
+
+func (o taskSortOption) applySelect(sb sq.SelectBuilder) sq.SelectBuilder {
+	direction := "ASC"
+	if o.desc {
+		direction = "DESC"
+	}
+	return sb.OrderBy(
+		fmt.Sprintf("t.%s %s", o.field, direction),
+		fmt.Sprintf("t.id %s", direction),
+	)
+}
+
+func (o taskSortOption) applyCount(sb sq.SelectBuilder) sq.SelectBuilder {
+	return sb
+}
+
+func WithTaskSort(field TaskSortField, desc bool) GetTasksOption {
+	if !field.valid() {
+		field = TaskSortCreatedAt
+	}
+	return taskSortOption{field: field, desc: desc}
+}
+
+// TaskCursor points at the last row of a page. Value holds that row's sort
+// key: time.Time for the timestamp fields, int for cost and string for name.
+type TaskCursor struct {
+	Field TaskSortField
+	Desc  bool
+	Value any
+	ID    string
+}
+
+// WithTaskAfter continues a listing sorted by cursor.Field after the cursor
+// row using a (sort key, id) keyset predicate. It must be combined with the
+// matching WithTaskSort and does not affect the count.
+func WithTaskAfter(cursor *TaskCursor) GetTasksOption {
+	return taskOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if cursor == nil || !cursor.Field.valid() {
+				return sb
+			}
+			operator := ">"
+			if cursor.Desc {
+				operator = "<"
+			}
+			return sb.Where(
+				sq.Expr(fmt.Sprintf("(t.%s, t.id) %s (?, ?)", cursor.Field, operator), cursor.Value, cursor.ID),
+			)
+		},
+	}
+}
+
+// taskSkipCountOption tells GetTasks not to run the COUNT query.
+type taskSkipCountOption struct{}
+
+func (taskSkipCountOption) applySelect(sb sq.SelectBuilder) sq.SelectBuilder {
+	return sb
+}
+
+func (taskSkipCountOption) applyCount(sb sq.SelectBuilder) sq.SelectBuilder {
+	return sb
+}
+
+func WithoutTaskCount() GetTasksOption {
+	return taskSkipCountOption{}
+}
+
 func WithTaskLimit(limit int) GetTasksOption {
 	return taskOptionFunc{
 		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
@@ -280,16 +429,26 @@ func (s *SqlStorage) GetTaskByID(ctx context.Context, id string) (*domain.Task,
 func (s *SqlStorage) GetTasks(ctx context.Context, opts ...GetTasksOption) ([]*domain.Task, int, error) {
 	sb := sq.Select(taskSelectColumns...).
 		From(fmt.Sprintf("%s t", taskTableName)).
-		OrderBy("t.created_at DESC").
 		PlaceholderFormat(sq.Dollar)
 
+	sorted, skipCount := false, false
 	for _, opt := range opts {
 		if opt == nil {
 			continue
 		}
+		switch opt.(type) {
+		case taskSortOption:
+			sorted = true
+		case taskSkipCountOption:
+			skipCount = true
+		}
 		sb = opt.applySelect(sb)
 	}
 
+	if !sorted {
+		sb = WithTaskSort(TaskSortCreatedAt, true).applySelect(sb)
+	}
+
 	query, args := sb.MustSql()
 
 	tasks := make([]*domain.Task, 0)
@@ -299,6 +458,10 @@ func (s *SqlStorage) GetTasks(ctx context.Context, opts ...GetTasksOption) ([]*d
 		return nil, 0, ErrTaskInternal
 	}
 
+	if skipCount {
+		return tasks, 0, nil
+	}
+
 	count, err := s.CountTasks(ctx, opts...)
 	if err != nil {
 		s.logger.Error("failed to count tasks", zap.Error(err))
@@ -332,7 +495,10 @@ func (s *SqlStorage) CountTasks(ctx context.Context, opts ...GetTasksOption) (in
 }
 
 func (s *SqlStorage) CreateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	id := uuid.NewString()
+	id := task.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
 	query, args := sq.Insert(taskTableName).
 		Columns(
 			"id",
@@ -354,7 +520,7 @@ func (s *SqlStorage) CreateTask(ctx context.Context, task *domain.Task) (*domain
 			task.MembersCount,
 			normalizeTaskMeta(task.Meta),
 		).
-		Suffix("RETURNING id, customer_id, name, description, verification_type, cost, members_count, COALESCE(meta, '{}'::jsonb) AS meta, created_at, updated_at").
+		Suffix(taskReturningColumns).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
@@ -387,7 +553,7 @@ func (s *SqlStorage) UpdateTask(ctx context.Context, task *domain.Task) (*domain
 		Set("meta", normalizeTaskMeta(task.Meta)).
 		Set("updated_at", sq.Expr("NOW()")).
 		Where(sq.Eq{"id": task.ID}).
-		Suffix("RETURNING id, customer_id, name, description, verification_type, cost, members_count, COALESCE(meta, '{}'::jsonb) AS meta, created_at, updated_at").
+		Suffix(taskReturningColumns).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
@@ -0,0 +1,126 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+func participantCounterColumn(status domain.Status) string {
+	switch status {
+	case domain.StatusInProgress:
+		return "participants_pending"
+	case domain.StatusCompleted:
+		return "participants_completed"
+	case domain.StatusApproved:
+		return "participants_approved"
+	case domain.StatusRejected:
+		return "participants_rejected"
+	case domain.StatusCancelled:
+		return "participants_cancelled"
+	default:
+		return ""
+	}
+}
+
+// moveParticipantCounter moves one participant of taskID from the from
+// counter to the to counter. An empty status skips that side, so creating a
+// participation passes from == "". Moves into or out of approved also bump
+// the task's updated_at. It must run in the same transaction as the
+// user_tasks change it mirrors.
+func (s *SqlStorage) moveParticipantCounter(ctx context.Context, taskID string, from, to domain.Status) error {
+	if from == to {
+		return nil
+	}
+
+	ub := sq.Update(taskTableName).
+		Where(sq.Eq{"id": taskID}).
+		PlaceholderFormat(sq.Dollar)
+
+	changed := false
+	if column := participantCounterColumn(from); column != "" {
+		ub = ub.Set(column, sq.Expr(fmt.Sprintf("GREATEST(%s - 1, 0)", column)))
+		changed = true
+	}
+	if column := participantCounterColumn(to); column != "" {
+		ub = ub.Set(column, sq.Expr(fmt.Sprintf("%s + 1", column)))
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	// The approved count decides whether the task is closed, so the search
+	// sweep has to see the task again when it changes.
+	if from == domain.StatusApproved || to == domain.StatusApproved {
+		ub = ub.Set("updated_at", sq.Expr("NOW()"))
+	}
+
+	query, args := ub.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error(
+			"failed to update participant counters",
+			zap.Error(err),
+			zap.String("task_id", taskID),
+			zap.String("from", from.String()),
+			zap.String("to", to.String()),
+		)
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+// RecomputeParticipantCounters rebuilds the participant counters from
+// user_tasks and returns how many tasks had drifted. An empty taskIDs repairs
+// every task.
+func (s *SqlStorage) RecomputeParticipantCounters(ctx context.Context, taskIDs []string) (int, error) {
+	counts := sq.Select(
+		"t.id",
+		"COUNT(ut.task_id) FILTER (WHERE ut.status = 'pending') AS pending",
+		"COUNT(ut.task_id) FILTER (WHERE ut.status = 'completed') AS completed",
+		"COUNT(ut.task_id) FILTER (WHERE ut.status = 'approved') AS approved",
+		"COUNT(ut.task_id) FILTER (WHERE ut.status = 'rejected') AS rejected",
+		"COUNT(ut.task_id) FILTER (WHERE ut.status = 'cancelled') AS cancelled",
+	).
+		From(fmt.Sprintf("%s t", taskTableName)).
+		LeftJoin(fmt.Sprintf("%s ut ON ut.task_id = t.id", userTaskTableName)).
+		GroupBy("t.id")
+
+	if len(taskIDs) > 0 {
+		counts = counts.Where(sq.Eq{"t.id": taskIDs})
+	}
+
+	query, args := sq.Update(taskTableName).
+		Set("participants_pending", sq.Expr("c.pending")).
+		Set("participants_completed", sq.Expr("c.completed")).
+		Set("participants_approved", sq.Expr("c.approved")).
+		Set("participants_rejected", sq.Expr("c.rejected")).
+		Set("participants_cancelled", sq.Expr("c.cancelled")).
+		FromSelect(counts, "c").
+		Where(`tasks.id = c.id AND (
+			tasks.participants_pending <> c.pending OR
+			tasks.participants_completed <> c.completed OR
+			tasks.participants_approved <> c.approved OR
+			tasks.participants_rejected <> c.rejected OR
+			tasks.participants_cancelled <> c.cancelled)`).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to recompute participant counters", zap.Error(err))
+		return 0, ErrTaskInternal
+	}
+
+	repaired, err := result.RowsAffected()
+	if err != nil {
+		s.logger.Error("failed to check affected rows when recomputing participant counters", zap.Error(err))
+		return 0, ErrTaskInternal
+	}
+
+	return int(repaired), nil
+}
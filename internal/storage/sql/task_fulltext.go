@@ -0,0 +1,131 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const defaultSearchLanguage = "simple"
+
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// taskSearchVector weighs the name above the description. The language is
+// inlined as a literal rather than bound so that the planner can match the
+// expression against the GIN index built for that language.
+func taskSearchVector(language string) string {
+	return fmt.Sprintf(
+		"(setweight(to_tsvector('%[1]s'::regconfig, coalesce(t.name, '')), 'A') || "+
+			"setweight(to_tsvector('%[1]s'::regconfig, coalesce(t.description, '')), 'B'))",
+		language,
+	)
+}
+
+// taskSearchQuery joins the tsquery as column q: the web-search query of text
+// OR-ed with the plain-text query of every tag, so that tags are never parsed
+// as query syntax.
+func taskSearchQuery(language, text string, tags []string) (string, []any) {
+	parts := []string{fmt.Sprintf("websearch_to_tsquery('%s'::regconfig, ?)", language)}
+	args := []any{text}
+	for _, tag := range tags {
+		parts = append(parts, fmt.Sprintf("plainto_tsquery('%s'::regconfig, ?)", language))
+		args = append(args, tag)
+	}
+	return fmt.Sprintf("CROSS JOIN (SELECT %s) AS query(q)", strings.Join(parts, " || ")), args
+}
+
+// openTaskCondition matches the tasks that still take participants. Closed
+// tasks are removed from the search index, so full-text search leaves them
+// out as well.
+const openTaskCondition = "(t.members_count = 0 OR t.participants_approved < t.members_count)"
+
+// fullTextSearchQueries builds the page and count queries of
+// SearchTaskIDsFullText.
+func fullTextSearchQueries(
+	language, text string,
+	tags []string,
+	limit, offset int,
+	opts ...GetTasksOption,
+) (sq.SelectBuilder, sq.SelectBuilder) {
+	vector := taskSearchVector(language)
+	tsQuery, tsArgs := taskSearchQuery(language, text, tags)
+
+	sb := sq.Select("t.id").
+		From(fmt.Sprintf("%s t", taskTableName)).
+		JoinClause(tsQuery, tsArgs...).
+		Where(vector+" @@ q").
+		Where(openTaskCondition).
+		OrderBy("ts_rank_cd("+vector+", q) DESC", "t.created_at DESC", "t.id").
+		PlaceholderFormat(sq.Dollar)
+
+	countSb := sq.Select("COUNT(*)").
+		From(fmt.Sprintf("%s t", taskTableName)).
+		JoinClause(tsQuery, tsArgs...).
+		Where(vector + " @@ q").
+		Where(openTaskCondition).
+		PlaceholderFormat(sq.Dollar)
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applySelect(sb)
+		countSb = opt.applyCount(countSb)
+	}
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+	if offset > 0 {
+		sb = sb.Offset(uint64(offset))
+	}
+
+	return sb, countSb
+}
+
+// SearchTaskIDsFullText ranks tasks against a web-search style query
+// ("quoted phrases", or, -exclusion), widened by each tag taken as plain text,
+// and returns one page of ids, best first, with the total number of matches.
+// Closed tasks are never returned. language is a Postgres text search configuration such as "simple" or
+// "russian". opts narrow the matches like the filters of GetTasks; their
+// limit, offset and sort options do not apply.
+func (s *SqlStorage) SearchTaskIDsFullText(
+	ctx context.Context,
+	text string,
+	tags []string,
+	language string,
+	limit, offset int,
+	opts ...GetTasksOption,
+) ([]string, int, error) {
+	if language == "" {
+		language = defaultSearchLanguage
+	}
+	if !searchLanguagePattern.MatchString(language) {
+		s.logger.Error("invalid full-text search language", zap.String("language", language))
+		return nil, 0, ErrTaskInvalid
+	}
+
+	sb, countSb := fullTextSearchQueries(language, text, tags, limit, offset, opts...)
+
+	query, args := sb.MustSql()
+
+	ids := make([]string, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &ids, query, args...); err != nil {
+		s.logger.Error("failed to search tasks", zap.Error(err), zap.String("query", text))
+		return nil, 0, ErrTaskInternal
+	}
+
+	query, args = countSb.MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count searched tasks", zap.Error(err), zap.String("query", text))
+		return nil, 0, ErrTaskInternal
+	}
+
+	return ids, count, nil
+}
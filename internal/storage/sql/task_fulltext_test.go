@@ -0,0 +1,19 @@
+package sql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFullTextSearchQueriesExcludeClosedTasks(t *testing.T) {
+	// WithTaskMinOpenSlots(0) adds no predicate, so closed tasks are left
+	// out by the query itself.
+	sb, countSb := fullTextSearchQueries("simple", "clean", []string{"park"}, 10, 0, WithTaskMinOpenSlots(0))
+
+	for name, builder := range map[string]interface{ MustSql() (string, []any) }{"page": sb, "count": countSb} {
+		query, _ := builder.MustSql()
+		if !strings.Contains(query, openTaskCondition) {
+			t.Fatalf("%s query %q does not exclude tasks with participants_approved >= members_count", name, query)
+		}
+	}
+}
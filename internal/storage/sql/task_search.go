@@ -14,15 +14,19 @@ import (
 )
 
 const (
-	searchStateTable = "search_index_state"
-	defaultCursorID  = 1
+	searchStateTable   = "search_index_state"
+	searchEntriesTable = "search_index_entries"
+	defaultCursorID    = 1
 )
 
-func (s *SqlStorage) GetTasksUpdatedAfter(ctx context.Context, after time.Time, limit int) ([]*domain.Task, error) {
+// GetTasksUpdatedAfter returns tasks ordered by (updated_at, id) that come
+// strictly after cursor. The keyset keeps tasks sharing an updated_at from
+// being skipped at batch boundaries.
+func (s *SqlStorage) GetTasksUpdatedAfter(ctx context.Context, after domain.SearchCursor, limit int) ([]*domain.Task, error) {
 	sb := sq.Select(taskSelectColumns...).
 		From(fmt.Sprintf("%s t", taskTableName)).
-		Where(sq.Gt{"t.updated_at": after}).
-		OrderBy("t.updated_at ASC").
+		Where(sq.Expr("(t.updated_at, t.id) > (?, ?)", after.UpdatedAt, after.ID)).
+		OrderBy("t.updated_at ASC", "t.id ASC").
 		PlaceholderFormat(sq.Dollar)
 
 	if limit > 0 {
@@ -33,7 +37,12 @@ func (s *SqlStorage) GetTasksUpdatedAfter(ctx context.Context, after time.Time,
 
 	tasks := make([]*domain.Task, 0)
 	if err := s.trf.Transaction(ctx).SelectContext(ctx, &tasks, query, args...); err != nil {
-		s.logger.Error("failed to get tasks updated after cursor", zap.Error(err), zap.Time("after", after))
+		s.logger.Error(
+			"failed to get tasks updated after cursor",
+			zap.Error(err),
+			zap.Time("after", after.UpdatedAt),
+			zap.String("after_id", after.ID),
+		)
 		return nil, ErrTaskInternal
 	}
 
@@ -66,38 +75,180 @@ func (s *SqlStorage) GetTasksByIDs(ctx context.Context, ids []string) ([]*domain
 	return tasks, nil
 }
 
-func (s *SqlStorage) LoadSearchCursor(ctx context.Context) (time.Time, error) {
-	query, args := sq.Select("last_synced_at").
+func (s *SqlStorage) LoadSearchCursor(ctx context.Context) (domain.SearchCursor, error) {
+	query, args := sq.Select("last_synced_at", "last_synced_id").
 		From(searchStateTable).
 		Where(sq.Eq{"id": defaultCursorID}).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
-	var cursor time.Time
+	var cursor domain.SearchCursor
 	err := s.trf.Transaction(ctx).GetContext(ctx, &cursor, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return time.Time{}, nil
+			return domain.SearchCursor{}, nil
 		}
 		s.logger.Error("failed to load search cursor", zap.Error(err))
-		return time.Time{}, ErrTaskInternal
+		return domain.SearchCursor{}, ErrTaskInternal
 	}
 
 	return cursor, nil
 }
 
-func (s *SqlStorage) SaveSearchCursor(ctx context.Context, cursor time.Time) error {
+func (s *SqlStorage) SaveSearchCursor(ctx context.Context, cursor domain.SearchCursor) error {
 	query, args := sq.Insert(searchStateTable).
-		Columns("id", "last_synced_at").
-		Values(defaultCursorID, cursor).
-		Suffix("ON CONFLICT (id) DO UPDATE SET last_synced_at = EXCLUDED.last_synced_at").
+		Columns("id", "last_synced_at", "last_synced_id").
+		Values(defaultCursorID, cursor.UpdatedAt, cursor.ID).
+		Suffix("ON CONFLICT (id) DO UPDATE SET last_synced_at = EXCLUDED.last_synced_at, last_synced_id = EXCLUDED.last_synced_id").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error(
+			"failed to save search cursor",
+			zap.Error(err),
+			zap.Time("cursor", cursor.UpdatedAt),
+			zap.String("cursor_id", cursor.ID),
+		)
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) MarkTaskIndexed(ctx context.Context, taskID string) error {
+	query, args := sq.Insert(searchEntriesTable).
+		Columns("task_id").
+		Values(taskID).
+		Suffix("ON CONFLICT (task_id) DO UPDATE SET indexed_at = NOW()").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark task indexed", zap.Error(err), zap.String("task_id", taskID))
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) MarkTaskUnindexed(ctx context.Context, taskID string) error {
+	query, args := sq.Delete(searchEntriesTable).
+		Where(sq.Eq{"task_id": taskID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to mark task unindexed", zap.Error(err), zap.String("task_id", taskID))
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+// GetOrphanedIndexEntries returns ids held by the search engine whose task was
+// deleted or is closed.
+func (s *SqlStorage) GetOrphanedIndexEntries(ctx context.Context, limit int) ([]string, error) {
+	sb := sq.Select("e.task_id").
+		From(fmt.Sprintf("%s e", searchEntriesTable)).
+		LeftJoin(fmt.Sprintf("%s t ON t.id = e.task_id", taskTableName)).
+		Where(sq.Or{
+			sq.Eq{"t.id": nil},
+			sq.Expr("t.members_count > 0 AND t.participants_approved >= t.members_count"),
+		}).
+		OrderBy("e.indexed_at ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+
+	query, args := sb.MustSql()
+
+	ids := make([]string, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &ids, query, args...); err != nil {
+		s.logger.Error("failed to get orphaned index entries", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	return ids, nil
+}
+
+// ResetSearchCursor moves the sync position back to the start so that the
+// next sweep of the indexer walks every task again.
+func (s *SqlStorage) ResetSearchCursor(ctx context.Context) error {
+	query, args := sq.Update(searchStateTable).
+		Set("last_synced_at", sq.Expr("DEFAULT")).
+		Set("last_synced_id", sq.Expr("DEFAULT")).
+		Where(sq.Eq{"id": defaultCursorID}).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
 	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
-		s.logger.Error("failed to save search cursor", zap.Error(err), zap.Time("cursor", cursor))
+		s.logger.Error("failed to reset search cursor", zap.Error(err))
 		return ErrTaskInternal
 	}
 
 	return nil
 }
+
+// RecordIndexerRun stores the outcome of an indexer run; cause is empty when
+// the run succeeded.
+func (s *SqlStorage) RecordIndexerRun(ctx context.Context, cause string) error {
+	ib := sq.Insert(searchStateTable).PlaceholderFormat(sq.Dollar)
+	if cause == "" {
+		ib = ib.Columns("id", "last_run_at", "last_success_at").
+			Values(defaultCursorID, sq.Expr("NOW()"), sq.Expr("NOW()")).
+			Suffix("ON CONFLICT (id) DO UPDATE SET last_run_at = EXCLUDED.last_run_at, last_success_at = EXCLUDED.last_success_at")
+	} else {
+		ib = ib.Columns("id", "last_run_at", "last_error", "last_error_at").
+			Values(defaultCursorID, sq.Expr("NOW()"), truncate(cause, 1024), sq.Expr("NOW()")).
+			Suffix("ON CONFLICT (id) DO UPDATE SET last_run_at = EXCLUDED.last_run_at, last_error = EXCLUDED.last_error, last_error_at = EXCLUDED.last_error_at")
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to record indexer run", zap.Error(err))
+		return ErrTaskInternal
+	}
+
+	return nil
+}
+
+// GetIndexerState returns the cursor and run bookkeeping; a fresh database
+// yields the zero state.
+func (s *SqlStorage) GetIndexerState(ctx context.Context) (*domain.IndexerState, error) {
+	query, args := sq.Select("last_synced_at", "last_synced_id", "last_run_at", "last_success_at", "last_error", "last_error_at").
+		From(searchStateTable).
+		Where(sq.Eq{"id": defaultCursorID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	state := new(domain.IndexerState)
+	if err := s.trf.Transaction(ctx).GetContext(ctx, state, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &domain.IndexerState{}, nil
+		}
+		s.logger.Error("failed to get indexer state", zap.Error(err))
+		return nil, ErrTaskInternal
+	}
+
+	return state, nil
+}
+
+// GetLatestTaskUpdate returns the newest updated_at of all tasks, or the zero
+// time when there are none.
+func (s *SqlStorage) GetLatestTaskUpdate(ctx context.Context) (time.Time, error) {
+	query, args := sq.Select("COALESCE(MAX(updated_at), 'epoch'::timestamptz)").
+		From(taskTableName).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var latest time.Time
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &latest, query, args...); err != nil {
+		s.logger.Error("failed to get latest task update", zap.Error(err))
+		return time.Time{}, ErrTaskInternal
+	}
+
+	return latest, nil
+}
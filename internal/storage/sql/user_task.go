@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strings"
 
 	sq "github.com/Masterminds/squirrel"
@@ -22,7 +23,114 @@ var userTaskSelectColumns = []string{
 	"updated_at",
 }
 
+type GetUserTasksOption interface {
+	taskOption
+}
+
+func WithUserTaskUserID(userID string) GetUserTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if userID != "" {
+			sb = sb.Where(sq.Eq{"ut.user_id": userID})
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+func WithUserTaskTaskID(taskID string) GetUserTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if taskID != "" {
+			sb = sb.Where(sq.Eq{"ut.task_id": taskID})
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+func WithUserTaskStatuses(statuses []domain.Status) GetUserTasksOption {
+	where := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		filtered := make([]domain.Status, 0, len(statuses))
+		for _, status := range statuses {
+			if status != "" {
+				filtered = append(filtered, status)
+			}
+		}
+		if len(filtered) > 0 {
+			sb = sb.Where(sq.Eq{"ut.status": filtered})
+		}
+		return sb
+	}
+	return taskOptionFunc{selectFn: where, countFn: where}
+}
+
+func WithUserTaskLimit(limit int) GetUserTasksOption {
+	return WithTaskLimit(limit)
+}
+
+func WithUserTaskOffset(offset int) GetUserTasksOption {
+	return WithTaskOffset(offset)
+}
+
+func (s *SqlStorage) GetUserTasks(ctx context.Context, opts ...GetUserTasksOption) ([]*domain.UserTask, int, error) {
+	columns := make([]string, 0, len(userTaskSelectColumns))
+	for _, column := range userTaskSelectColumns {
+		columns = append(columns, "ut."+column)
+	}
+
+	sb := sq.Select(columns...).
+		From(fmt.Sprintf("%s ut", userTaskTableName)).
+		OrderBy("ut.created_at DESC", "ut.user_id", "ut.task_id").
+		PlaceholderFormat(sq.Dollar)
+
+	countSb := sq.Select("COUNT(*)").
+		From(fmt.Sprintf("%s ut", userTaskTableName)).
+		PlaceholderFormat(sq.Dollar)
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applySelect(sb)
+		countSb = opt.applyCount(countSb)
+	}
+
+	query, args := sb.MustSql()
+
+	userTasks := make([]*domain.UserTask, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &userTasks, query, args...); err != nil {
+		s.logger.Error("failed to get user tasks", zap.Error(err))
+		return nil, 0, ErrUserTaskInternal
+	}
+
+	query, args = countSb.MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count user tasks", zap.Error(err))
+		return nil, 0, ErrUserTaskInternal
+	}
+
+	return userTasks, count, nil
+}
+
 func (s *SqlStorage) CreateUserTask(ctx context.Context, userTask *domain.UserTask) (*domain.UserTask, error) {
+	var created *domain.UserTask
+	err := s.Do(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.insertUserTask(ctx, userTask)
+		if err != nil {
+			return err
+		}
+		return s.moveParticipantCounter(ctx, created.TaskID, "", created.Status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *SqlStorage) insertUserTask(ctx context.Context, userTask *domain.UserTask) (*domain.UserTask, error) {
 	query, args := sq.Insert(userTaskTableName).
 		Columns("user_id", "task_id", "status").
 		Values(userTask.UserID, userTask.TaskID, userTask.Status).
@@ -57,9 +165,68 @@ func (s *SqlStorage) CreateUserTask(ctx context.Context, userTask *domain.UserTa
 }
 
 func (s *SqlStorage) UpdateUserTaskStatus(ctx context.Context, userID, taskID string, status domain.Status) (*domain.UserTask, error) {
-	query, args := sq.Update(userTaskTableName).
+	var updated *domain.UserTask
+	err := s.Do(ctx, func(ctx context.Context) error {
+		previous, err := s.lockUserTaskStatus(ctx, userID, taskID)
+		if err != nil {
+			return err
+		}
+
+		updated, err = s.setUserTaskStatus(ctx, userID, taskID, status)
+		if err != nil {
+			return err
+		}
+
+		return s.moveParticipantCounter(ctx, taskID, previous, updated.Status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *SqlStorage) lockUserTaskStatus(ctx context.Context, userID, taskID string) (domain.Status, error) {
+	query, args := sq.Select("status").
+		From(userTaskTableName).
+		Where(sq.Eq{"user_id": userID, "task_id": taskID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var status domain.Status
+	err := s.trf.Transaction(ctx).GetContext(ctx, &status, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrUserTaskNotFound
+		}
+
+		s.logger.Error(
+			"failed to lock user task",
+			zap.Error(err),
+			zap.String("user_id", userID),
+			zap.String("task_id", taskID),
+		)
+
+		return "", ErrUserTaskInternal
+	}
+
+	return status, nil
+}
+
+func (s *SqlStorage) setUserTaskStatus(ctx context.Context, userID, taskID string, status domain.Status) (*domain.UserTask, error) {
+	ub := sq.Update(userTaskTableName).
 		Set("status", status).
-		Set("updated_at", sq.Expr("NOW()")).
+		Set("updated_at", sq.Expr("NOW()"))
+
+	switch status {
+	case domain.StatusCompleted:
+		ub = ub.Set("completed_at", sq.Expr("NOW()"))
+	case domain.StatusApproved:
+		ub = ub.Set("approved_at", sq.Expr("NOW()"))
+	}
+
+	query, args := ub.
 		Where(sq.Eq{"user_id": userID, "task_id": taskID}).
 		Suffix("RETURNING " + strings.Join(userTaskSelectColumns, ", ")).
 		PlaceholderFormat(sq.Dollar).
@@ -0,0 +1,339 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"DobrikaDev/task-service/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookSubscriptionTableName = "webhook_subscriptions"
+	webhookDeliveryTableName     = "webhook_deliveries"
+)
+
+var webhookSubscriptionSelectColumns = []string{
+	"id",
+	"customer_id",
+	"url",
+	"secret",
+	"event_types",
+	"active",
+	"created_at",
+	"updated_at",
+}
+
+var webhookDeliverySelectColumns = []string{
+	"d.id",
+	"d.subscription_id",
+	"d.event_id",
+	"d.event_type",
+	"d.payload",
+	"d.status",
+	"d.attempts",
+	"d.next_attempt_at",
+	"d.last_status_code",
+	"d.last_error",
+	"d.delivered_at",
+	"d.created_at",
+	"d.updated_at",
+}
+
+func (s *SqlStorage) CreateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	query, args := sq.Insert(webhookSubscriptionTableName).
+		Columns("id", "customer_id", "url", "secret", "event_types", "active").
+		Values(
+			subscription.ID,
+			subscription.CustomerID,
+			subscription.URL,
+			subscription.Secret,
+			subscription.EventTypes,
+			subscription.Active,
+		).
+		Suffix("RETURNING " + strings.Join(webhookSubscriptionSelectColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var created domain.WebhookSubscription
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &created, query, args...); err != nil {
+		s.logger.Error("failed to create webhook subscription", zap.Error(err), zap.String("customer_id", subscription.CustomerID))
+		return nil, ErrWebhookInternal
+	}
+
+	return &created, nil
+}
+
+func (s *SqlStorage) UpdateWebhookSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	query, args := sq.Update(webhookSubscriptionTableName).
+		Set("url", subscription.URL).
+		Set("event_types", subscription.EventTypes).
+		Set("active", subscription.Active).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": subscription.ID, "customer_id": subscription.CustomerID}).
+		Suffix("RETURNING " + strings.Join(webhookSubscriptionSelectColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var updated domain.WebhookSubscription
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &updated, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		s.logger.Error("failed to update webhook subscription", zap.Error(err), zap.String("id", subscription.ID))
+		return nil, ErrWebhookInternal
+	}
+
+	return &updated, nil
+}
+
+func (s *SqlStorage) GetWebhookSubscription(ctx context.Context, id, customerID string) (*domain.WebhookSubscription, error) {
+	query, args := sq.Select(webhookSubscriptionSelectColumns...).
+		From(webhookSubscriptionTableName).
+		Where(sq.Eq{"id": id, "customer_id": customerID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var subscription domain.WebhookSubscription
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &subscription, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		s.logger.Error("failed to get webhook subscription", zap.Error(err), zap.String("id", id))
+		return nil, ErrWebhookInternal
+	}
+
+	return &subscription, nil
+}
+
+// GetWebhookSubscriptions lists the customer's subscriptions, optionally only
+// the active ones.
+func (s *SqlStorage) GetWebhookSubscriptions(ctx context.Context, customerID string, activeOnly bool) ([]*domain.WebhookSubscription, error) {
+	sb := sq.Select(webhookSubscriptionSelectColumns...).
+		From(webhookSubscriptionTableName).
+		Where(sq.Eq{"customer_id": customerID}).
+		OrderBy("created_at ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if activeOnly {
+		sb = sb.Where(sq.Eq{"active": true})
+	}
+
+	query, args := sb.MustSql()
+
+	subscriptions := make([]*domain.WebhookSubscription, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &subscriptions, query, args...); err != nil {
+		s.logger.Error("failed to get webhook subscriptions", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrWebhookInternal
+	}
+
+	return subscriptions, nil
+}
+
+func (s *SqlStorage) DeleteWebhookSubscription(ctx context.Context, id, customerID string) error {
+	query, args := sq.Delete(webhookSubscriptionTableName).
+		Where(sq.Eq{"id": id, "customer_id": customerID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.logger.Error("failed to delete webhook subscription", zap.Error(err), zap.String("id", id))
+		return ErrWebhookInternal
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ErrWebhookInternal
+	}
+	if rowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnqueueWebhookDeliveries stores pending deliveries. A delivery that already
+// exists for the same subscription and event is skipped, which makes
+// re-published outbox events harmless.
+func (s *SqlStorage) EnqueueWebhookDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(webhookDeliveryTableName).
+		Columns("subscription_id", "event_id", "event_type", "payload").
+		Suffix("ON CONFLICT (subscription_id, event_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	for _, delivery := range deliveries {
+		ib = ib.Values(delivery.SubscriptionID, delivery.EventID, delivery.EventType, delivery.Payload)
+	}
+
+	query, args := ib.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to enqueue webhook deliveries", zap.Error(err))
+		return ErrWebhookInternal
+	}
+
+	return nil
+}
+
+// LockDueWebhookDeliveries returns up to limit pending deliveries whose next
+// attempt is due, joined with the target url and secret, and locks them with
+// SKIP LOCKED. It must be called inside a transaction.
+func (s *SqlStorage) LockDueWebhookDeliveries(ctx context.Context, limit int) ([]*domain.WebhookDelivery, error) {
+	query, args := sq.Select(webhookDeliverySelectColumns...).
+		Columns("s.url", "s.secret").
+		From(fmt.Sprintf("%s d", webhookDeliveryTableName)).
+		Join(fmt.Sprintf("%s s ON s.id = d.subscription_id", webhookSubscriptionTableName)).
+		Where(sq.Eq{"d.status": domain.WebhookDeliveryPending}).
+		Where(sq.Expr("d.next_attempt_at <= NOW()")).
+		OrderBy("d.next_attempt_at ASC", "d.id ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF d SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	deliveries := make([]*domain.WebhookDelivery, 0, limit)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &deliveries, query, args...); err != nil {
+		s.logger.Error("failed to lock due webhook deliveries", zap.Error(err))
+		return nil, ErrWebhookInternal
+	}
+
+	return deliveries, nil
+}
+
+// ClaimWebhookDeliveries postpones the next attempt of the given deliveries
+// to until while they are being sent.
+func (s *SqlStorage) ClaimWebhookDeliveries(ctx context.Context, ids []int64, until time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args := sq.Update(webhookDeliveryTableName).
+		Set("next_attempt_at", until).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to claim webhook deliveries", zap.Error(err), zap.Int64s("ids", ids))
+		return ErrWebhookInternal
+	}
+
+	return nil
+}
+
+// RecordWebhookAttempt stores the outcome of one delivery attempt.
+func (s *SqlStorage) RecordWebhookAttempt(
+	ctx context.Context,
+	id int64,
+	status domain.WebhookDeliveryStatus,
+	statusCode int,
+	cause string,
+	nextAttemptAt time.Time,
+) error {
+	ub := sq.Update(webhookDeliveryTableName).
+		Set("status", status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	if statusCode > 0 {
+		ub = ub.Set("last_status_code", statusCode)
+	} else {
+		ub = ub.Set("last_status_code", nil)
+	}
+	if cause != "" {
+		ub = ub.Set("last_error", truncate(cause, 1024))
+	} else {
+		ub = ub.Set("last_error", nil)
+	}
+	if status == domain.WebhookDeliverySucceeded {
+		ub = ub.Set("delivered_at", sq.Expr("NOW()"))
+	}
+
+	query, args := ub.MustSql()
+	if _, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...); err != nil {
+		s.logger.Error("failed to record webhook attempt", zap.Error(err), zap.Int64("id", id))
+		return ErrWebhookInternal
+	}
+
+	return nil
+}
+
+func (s *SqlStorage) GetWebhookDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, int, error) {
+	sb := sq.Select(webhookDeliverySelectColumns...).
+		From(fmt.Sprintf("%s d", webhookDeliveryTableName)).
+		Where(sq.Eq{"d.subscription_id": subscriptionID}).
+		OrderBy("d.created_at DESC", "d.id DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	if limit > 0 {
+		sb = sb.Limit(uint64(limit))
+	}
+	if offset > 0 {
+		sb = sb.Offset(uint64(offset))
+	}
+
+	query, args := sb.MustSql()
+
+	deliveries := make([]*domain.WebhookDelivery, 0)
+	if err := s.trf.Transaction(ctx).SelectContext(ctx, &deliveries, query, args...); err != nil {
+		s.logger.Error("failed to get webhook deliveries", zap.Error(err), zap.String("subscription_id", subscriptionID))
+		return nil, 0, ErrWebhookInternal
+	}
+
+	query, args = sq.Select("COUNT(*)").
+		From(webhookDeliveryTableName).
+		Where(sq.Eq{"subscription_id": subscriptionID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var count int
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...); err != nil {
+		s.logger.Error("failed to count webhook deliveries", zap.Error(err), zap.String("subscription_id", subscriptionID))
+		return nil, 0, ErrWebhookInternal
+	}
+
+	return deliveries, count, nil
+}
+
+// ResetWebhookDelivery puts a delivery of one of the customer's subscriptions
+// back into the pending queue with a fresh attempt budget.
+func (s *SqlStorage) ResetWebhookDelivery(ctx context.Context, id int64, customerID string) (*domain.WebhookDelivery, error) {
+	query, args := sq.Update(fmt.Sprintf("%s d", webhookDeliveryTableName)).
+		Set("status", domain.WebhookDeliveryPending).
+		Set("attempts", 0).
+		Set("next_attempt_at", sq.Expr("NOW()")).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"d.id": id}).
+		Where(sq.Expr(
+			fmt.Sprintf("EXISTS (SELECT 1 FROM %s s WHERE s.id = d.subscription_id AND s.customer_id = ?)", webhookSubscriptionTableName),
+			customerID,
+		)).
+		Suffix("RETURNING " + strings.Join(webhookDeliverySelectColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var delivery domain.WebhookDelivery
+	if err := s.trf.Transaction(ctx).GetContext(ctx, &delivery, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		s.logger.Error("failed to reset webhook delivery", zap.Error(err), zap.Int64("id", id))
+		return nil, ErrWebhookInternal
+	}
+
+	return &delivery, nil
+}
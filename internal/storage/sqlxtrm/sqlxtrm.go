@@ -37,6 +37,14 @@ func (stm *SqlxTransactionManager) DoWithCancel(ctx context.Context, fn func(con
 	return stm.manager.Do(ctx, fn)
 }
 
+func (stm *SqlxTransactionManager) DoNested(ctx context.Context, fn func(context.Context) error) error {
+	return stm.manager.DoWithSettings(
+		context.WithoutCancel(ctx),
+		trmSettings.Must(trmSettings.WithPropagation(trm.PropagationNested)),
+		fn,
+	)
+}
+
 func (stm *SqlxTransactionManager) DoWithTimeout(
 	ctx context.Context,
 	timeout time.Duration,
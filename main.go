@@ -7,12 +7,16 @@ import (
 	"DobrikaDev/task-service/utils/logger"
 	"context"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"go.uber.org/zap"
 )
 
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	cfg := config.MustLoadConfigFromFile("deployments/config.yaml")
 	logger, _ := logger.NewLogger()
 	defer logger.Sync()
@@ -24,11 +28,25 @@ func main() {
 		container.GetRpcServer(),
 	)
 
+	container.GetOutboxRelay()
+	container.GetWebhookDispatcher()
+	container.GetNotificationDispatcher()
+
 	logger.Info("Starting application with port", zap.String("port", cfg.Port))
 
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		logger.Info("Shutting down application")
+		container.Shutdown()
+	}()
+
 	err := container.GetGRPCServer().Serve(*container.GetNetListener())
 	if err != nil {
 		logger.Error("Error while serving grpcServer:", zap.Error(err))
 		os.Exit(1)
 	}
+
+	<-stopped
 }
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS participants_pending INTEGER NOT NULL DEFAULT 0 CHECK (participants_pending >= 0),
+    ADD COLUMN IF NOT EXISTS participants_completed INTEGER NOT NULL DEFAULT 0 CHECK (participants_completed >= 0),
+    ADD COLUMN IF NOT EXISTS participants_approved INTEGER NOT NULL DEFAULT 0 CHECK (participants_approved >= 0),
+    ADD COLUMN IF NOT EXISTS participants_rejected INTEGER NOT NULL DEFAULT 0 CHECK (participants_rejected >= 0),
+    ADD COLUMN IF NOT EXISTS participants_cancelled INTEGER NOT NULL DEFAULT 0 CHECK (participants_cancelled >= 0);
+
+UPDATE tasks t
+SET participants_pending = c.pending,
+    participants_completed = c.completed,
+    participants_approved = c.approved,
+    participants_rejected = c.rejected,
+    participants_cancelled = c.cancelled
+FROM (
+    SELECT task_id,
+           COUNT(*) FILTER (WHERE status = 'pending') AS pending,
+           COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+           COUNT(*) FILTER (WHERE status = 'approved') AS approved,
+           COUNT(*) FILTER (WHERE status = 'rejected') AS rejected,
+           COUNT(*) FILTER (WHERE status = 'cancelled') AS cancelled
+    FROM user_tasks
+    GROUP BY task_id
+) c
+WHERE t.id = c.task_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS participants_cancelled,
+    DROP COLUMN IF EXISTS participants_rejected,
+    DROP COLUMN IF EXISTS participants_approved,
+    DROP COLUMN IF EXISTS participants_completed,
+    DROP COLUMN IF EXISTS participants_pending;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_tasks
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ;
+
+UPDATE user_tasks SET completed_at = updated_at WHERE status = 'completed' AND completed_at IS NULL;
+UPDATE user_tasks SET approved_at = updated_at WHERE status = 'approved' AND approved_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_customer_id_created_at ON tasks (customer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_customer_id_created_at;
+ALTER TABLE user_tasks
+    DROP COLUMN IF EXISTS approved_at,
+    DROP COLUMN IF EXISTS completed_at;
+-- +goose StatementEnd
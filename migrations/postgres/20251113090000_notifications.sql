@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS notifications (
+    id VARCHAR(255) PRIMARY KEY,
+    recipient_id VARCHAR(255) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    type VARCHAR(128) NOT NULL,
+    task_id VARCHAR(255) NOT NULL DEFAULT '',
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    channels TEXT NOT NULL DEFAULT '',
+    send_after TIMESTAMPTZ NOT NULL DEFAULT now(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    sent_at TIMESTAMPTZ,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (recipient_id, event_id, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_id_created_at ON notifications (recipient_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON notifications (send_after) WHERE sent_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id VARCHAR(255) PRIMARY KEY,
+    channels TEXT NOT NULL DEFAULT '',
+    quiet_hours_start SMALLINT,
+    quiet_hours_end SMALLINT,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_preferences;
+DROP INDEX IF EXISTS idx_notifications_due;
+DROP INDEX IF EXISTS idx_notifications_recipient_id_created_at;
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
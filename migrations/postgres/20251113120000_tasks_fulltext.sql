@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The expression must match taskSearchVector in internal/storage/sql/task_fulltext.go
+-- for the configured language; add an index per language in use.
+CREATE INDEX IF NOT EXISTS idx_tasks_fulltext_simple ON tasks USING GIN (
+    (setweight(to_tsvector('simple'::regconfig, coalesce(name, '')), 'A') ||
+     setweight(to_tsvector('simple'::regconfig, coalesce(description, '')), 'B'))
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_fulltext_simple;
+-- +goose StatementEnd
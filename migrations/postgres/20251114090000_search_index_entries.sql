@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_index_entries records which tasks the search engine currently holds,
+-- so that orphans can be found and removed without listing the engine.
+CREATE TABLE IF NOT EXISTS search_index_entries (
+    task_id VARCHAR(255) PRIMARY KEY,
+    indexed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_index_entries;
+-- +goose StatementEnd
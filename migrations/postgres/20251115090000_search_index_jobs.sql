@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_index_jobs is the durable queue of tasks to push to or remove from
+-- the search engine. Rows are written in the transaction of the task change
+-- and deleted once the engine has accepted it.
+CREATE TABLE IF NOT EXISTS search_index_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    task_id VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_index_jobs_due ON search_index_jobs (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_search_index_jobs_task_id ON search_index_jobs (task_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_search_index_jobs_task_id;
+DROP INDEX IF EXISTS idx_search_index_jobs_due;
+DROP TABLE IF EXISTS search_index_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The indexer leader records the outcome of every periodic run here so that
+-- any replica can report it.
+ALTER TABLE search_index_state
+    ADD COLUMN IF NOT EXISTS last_run_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_success_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_error TEXT,
+    ADD COLUMN IF NOT EXISTS last_error_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE search_index_state
+    DROP COLUMN IF EXISTS last_error_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS last_success_at,
+    DROP COLUMN IF EXISTS last_run_at;
+-- +goose StatementEnd
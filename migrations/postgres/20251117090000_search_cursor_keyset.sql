@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The indexer cursor becomes the keyset (last_synced_at, last_synced_id), so
+-- that a batch ending inside a group of tasks with the same updated_at does
+-- not skip the rest of the group. Existing cursors keep their timestamp with
+-- an empty id, which re-syncs the tasks at exactly that timestamp once and
+-- recovers any that the old cursor skipped.
+ALTER TABLE search_index_state
+    ADD COLUMN IF NOT EXISTS last_synced_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_tasks_updated_at_id ON tasks (updated_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_updated_at_id;
+
+ALTER TABLE search_index_state
+    DROP COLUMN IF EXISTS last_synced_id;
+-- +goose StatementEnd
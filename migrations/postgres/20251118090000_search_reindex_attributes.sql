@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Index documents now carry the structured task attributes that search
+-- filters use. Queue every task once so that the indexer pushes them to the
+-- documents written before.
+INSERT INTO search_index_jobs (task_id)
+SELECT id FROM tasks;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_queries logs every SearchTasks call with its normalised query, and
+-- search_joins attributes UserJoinTask calls to the search they came from.
+-- Both are written asynchronously, so a join may arrive before its search.
+CREATE TABLE IF NOT EXISTS search_queries (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    query TEXT NOT NULL,
+    query_type VARCHAR(255) NOT NULL DEFAULT '',
+    filters JSONB NOT NULL DEFAULT '{}',
+    result_ids JSONB NOT NULL DEFAULT '[]',
+    result_count INTEGER NOT NULL DEFAULT 0,
+    total INTEGER NOT NULL DEFAULT 0,
+    latency_ms INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_queries_created_at ON search_queries (created_at);
+
+CREATE TABLE IF NOT EXISTS search_joins (
+    search_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    task_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (search_id, user_id, task_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_joins;
+DROP INDEX IF EXISTS idx_search_queries_created_at;
+DROP TABLE IF EXISTS search_queries;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_index_entries only learned about tasks indexed after it was created,
+-- so tasks indexed earlier and closed or deleted since were never removed
+-- from the engine. Record every existing task as indexed: reconciliation then
+-- deletes the ones that are no longer searchable.
+--
+-- Tasks deleted from this table before search_index_entries existed cannot
+-- be recovered here. Their documents stay in the engine until they are
+-- purged there once by hand, e.g. by deleting every document whose id is not
+-- in tasks, or by recreating the engine index and running
+-- `admin reindex -all`.
+INSERT INTO search_index_entries (task_id)
+SELECT id FROM tasks
+ON CONFLICT (task_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_cache_invalidations carries the task ids the indexer changed in the
+-- search engine to every replica, each of which keeps its own result cache.
+CREATE TABLE IF NOT EXISTS search_cache_invalidations (
+    id BIGSERIAL PRIMARY KEY,
+    task_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_cache_invalidations_created_at ON search_cache_invalidations (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_cache_invalidations;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The relay only picks the oldest unpublished event of each aggregate; this
+-- index answers that check.
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished_aggregate ON outbox_events (aggregate_id, id) WHERE published_at IS NULL;
+
+-- Retention deletes published events by age.
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON outbox_events (published_at) WHERE published_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_events_published_at;
+DROP INDEX IF EXISTS idx_outbox_events_unpublished_aggregate;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- search_results holds the task ids shown by every page of a logged search.
+-- Pages are written by whichever replica served them, possibly before the
+-- search row itself, so they are rows of their own rather than updates of
+-- search_queries.result_ids, which keeps the first page only.
+CREATE TABLE IF NOT EXISTS search_results (
+    search_id VARCHAR(255) NOT NULL,
+    task_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (search_id, task_id)
+);
+
+INSERT INTO search_results (search_id, task_id)
+SELECT q.id, r.task_id
+FROM search_queries q
+CROSS JOIN jsonb_array_elements_text(q.result_ids) AS r(task_id)
+ON CONFLICT (search_id, task_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_results;
+-- +goose StatementEnd
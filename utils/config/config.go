@@ -10,8 +10,13 @@ import (
 type Config struct {
 	Port string `mapstructure:"port" env:"PORT"`
 
-	SQL    DB           `mapstructure:"sql" env-prefix:"POSTGRES_"`
-	Search SearchConfig `mapstructure:"search" env-prefix:"SEARCH_"`
+	SQL          DB                 `mapstructure:"sql" env-prefix:"POSTGRES_"`
+	Search       SearchConfig       `mapstructure:"search" env-prefix:"SEARCH_"`
+	Outbox       OutboxConfig       `mapstructure:"outbox" env-prefix:"OUTBOX_"`
+	Webhook      WebhookConfig      `mapstructure:"webhook" env-prefix:"WEBHOOK_"`
+	Notification NotificationConfig `mapstructure:"notification" env-prefix:"NOTIFICATION_"`
+	Leader       LeaderConfig       `mapstructure:"leader" env-prefix:"LEADER_"`
+	Analytics    AnalyticsConfig    `mapstructure:"analytics" env-prefix:"ANALYTICS_"`
 }
 
 type DB struct {
@@ -23,12 +28,93 @@ type DB struct {
 }
 
 type SearchConfig struct {
-	BaseURL             string        `mapstructure:"base_url" env:"BASE_URL"`
-	IndexTimeout        time.Duration `mapstructure:"index_timeout" env:"INDEX_TIMEOUT"`
-	SearchTimeout       time.Duration `mapstructure:"search_timeout" env:"SEARCH_TIMEOUT"`
-	SchedulerInterval   time.Duration `mapstructure:"scheduler_interval" env:"SCHEDULER_INTERVAL"`
-	SchedulerBatchSize  int           `mapstructure:"scheduler_batch_size" env:"SCHEDULER_BATCH_SIZE"`
+	// Transport is "http" (JSON, BaseURL) or "grpc" (protobuf, GRPCAddress).
+	Transport     string        `mapstructure:"transport" env:"TRANSPORT"`
+	BaseURL       string        `mapstructure:"base_url" env:"BASE_URL"`
+	GRPCAddress   string        `mapstructure:"grpc_address" env:"GRPC_ADDRESS"`
+	IndexTimeout  time.Duration `mapstructure:"index_timeout" env:"INDEX_TIMEOUT"`
+	SearchTimeout time.Duration `mapstructure:"search_timeout" env:"SEARCH_TIMEOUT"`
+	// Retries apply to transient failures with full-jitter exponential backoff.
+	RetryMaxAttempts int           `mapstructure:"retry_max_attempts" env:"RETRY_MAX_ATTEMPTS"`
+	RetryBaseDelay   time.Duration `mapstructure:"retry_base_delay" env:"RETRY_BASE_DELAY"`
+	RetryMaxDelay    time.Duration `mapstructure:"retry_max_delay" env:"RETRY_MAX_DELAY"`
+	// The breaker opens after BreakerFailureThreshold consecutive transient
+	// failures and fails fast for BreakerCooldown before probing again.
+	BreakerFailureThreshold int           `mapstructure:"breaker_failure_threshold" env:"BREAKER_FAILURE_THRESHOLD"`
+	BreakerCooldown         time.Duration `mapstructure:"breaker_cooldown" env:"BREAKER_COOLDOWN"`
+	SchedulerInterval       time.Duration `mapstructure:"scheduler_interval" env:"SCHEDULER_INTERVAL"`
+	SchedulerBatchSize      int           `mapstructure:"scheduler_batch_size" env:"SCHEDULER_BATCH_SIZE"`
+	// Index jobs are retried SchedulerMaxRetries times with exponential
+	// backoff capped at JobMaxBackoff, then parked as dead.
 	SchedulerMaxRetries int           `mapstructure:"scheduler_max_retries" env:"SCHEDULER_MAX_RETRIES"`
+	JobMaxBackoff       time.Duration `mapstructure:"job_max_backoff" env:"JOB_MAX_BACKOFF"`
+	ReconcileInterval   time.Duration `mapstructure:"reconcile_interval" env:"RECONCILE_INTERVAL"`
+	// IndexRateLimit caps the tasks per second the indexer sends to the
+	// engine, counting every task of a bulk call; zero means unlimited.
+	IndexRateLimit float64 `mapstructure:"index_rate_limit" env:"INDEX_RATE_LIMIT"`
+	// The indexer sends IndexBatchSize tasks per bulk index call and runs up
+	// to IndexWorkers calls at once.
+	IndexBatchSize int `mapstructure:"index_batch_size" env:"INDEX_BATCH_SIZE"`
+	IndexWorkers   int `mapstructure:"index_workers" env:"INDEX_WORKERS"`
+	// FullTextMode is "primary" (Postgres only), "fallback" (Postgres when the
+	// search service fails or is not configured) or "disabled".
+	FullTextMode     string `mapstructure:"full_text_mode" env:"FULL_TEXT_MODE"`
+	FullTextLanguage string `mapstructure:"full_text_language" env:"FULL_TEXT_LANGUAGE"`
+	FullTextLimit    int    `mapstructure:"full_text_limit" env:"FULL_TEXT_LIMIT"`
+	// Search results are cached for CacheTTL; zero disables the cache.
+	CacheTTL        time.Duration `mapstructure:"cache_ttl" env:"CACHE_TTL"`
+	CacheMaxEntries int           `mapstructure:"cache_max_entries" env:"CACHE_MAX_ENTRIES"`
+	// Every replica applies the indexer's cache invalidations this often.
+	CacheInvalidationInterval time.Duration `mapstructure:"cache_invalidation_interval" env:"CACHE_INVALIDATION_INTERVAL"`
+}
+
+type OutboxConfig struct {
+	// Publisher is one of "log", "file" or "memory".
+	Publisher    string        `mapstructure:"publisher" env:"PUBLISHER"`
+	FilePath     string        `mapstructure:"file_path" env:"FILE_PATH"`
+	PollInterval time.Duration `mapstructure:"poll_interval" env:"POLL_INTERVAL"`
+	BatchSize    int           `mapstructure:"batch_size" env:"BATCH_SIZE"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff" env:"MAX_BACKOFF"`
+	// Published events are deleted once they are older than Retention.
+	Retention time.Duration `mapstructure:"retention" env:"RETENTION"`
+}
+
+type WebhookConfig struct {
+	PollInterval   time.Duration `mapstructure:"poll_interval" env:"POLL_INTERVAL"`
+	BatchSize      int           `mapstructure:"batch_size" env:"BATCH_SIZE"`
+	MaxAttempts    int           `mapstructure:"max_attempts" env:"MAX_ATTEMPTS"`
+	RequestTimeout time.Duration `mapstructure:"request_timeout" env:"REQUEST_TIMEOUT"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff" env:"MAX_BACKOFF"`
+	// Workers is the number of deliveries sent at once.
+	Workers int `mapstructure:"workers" env:"WORKERS"`
+}
+
+type NotificationConfig struct {
+	// Notifier is one of "console" or "memory".
+	Notifier     string        `mapstructure:"notifier" env:"NOTIFIER"`
+	PollInterval time.Duration `mapstructure:"poll_interval" env:"POLL_INTERVAL"`
+	BatchSize    int           `mapstructure:"batch_size" env:"BATCH_SIZE"`
+	MaxAttempts  int           `mapstructure:"max_attempts" env:"MAX_ATTEMPTS"`
+	// SendTimeout bounds one notification across all of its channels.
+	SendTimeout time.Duration `mapstructure:"send_timeout" env:"SEND_TIMEOUT"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff" env:"MAX_BACKOFF"`
+}
+
+// LeaderConfig tunes the advisory-lock election that keeps singleton jobs,
+// such as the search indexer, on one replica.
+type LeaderConfig struct {
+	RenewInterval time.Duration `mapstructure:"renew_interval" env:"RENEW_INTERVAL"`
+	QueryTimeout  time.Duration `mapstructure:"query_timeout" env:"QUERY_TIMEOUT"`
+}
+
+// AnalyticsConfig controls the search analytics log. Records are buffered up
+// to QueueSize and written in batches of BatchSize at least every
+// FlushInterval.
+type AnalyticsConfig struct {
+	Enabled       bool          `mapstructure:"enabled" env:"ENABLED"`
+	QueueSize     int           `mapstructure:"queue_size" env:"QUEUE_SIZE"`
+	BatchSize     int           `mapstructure:"batch_size" env:"BATCH_SIZE"`
+	FlushInterval time.Duration `mapstructure:"flush_interval" env:"FLUSH_INTERVAL"`
 }
 
 func LoadConfigFromFile(path string) (*Config, error) {